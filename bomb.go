@@ -0,0 +1,140 @@
+package csminify
+
+import (
+	"math"
+
+	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// bombTracker keeps track of the bomb state that isn't available through GameState().Bomb().
+type bombTracker struct {
+	parser  dem.Parser
+	c4      st.Entity // The bomb while it's carried or dropped, nil otherwise
+	planted st.Entity // The planted bomb, nil otherwise
+	site    rune      // 0 if unknown
+	defuser *common.Player
+}
+
+func newBombTracker(p dem.Parser) *bombTracker {
+	bt := &bombTracker{parser: p}
+
+	p.RegisterEventHandler(func(events.DataTablesParsed) {
+		bt.bindEntity("CC4", &bt.c4)
+		bt.bindEntity("CPlantedC4", &bt.planted)
+	})
+
+	p.RegisterEventHandler(func(events.RoundStart) {
+		bt.site = 0
+		bt.defuser = nil
+	})
+
+	p.RegisterEventHandler(func(e events.BombPlantBegin) {
+		bt.site = rune(e.Site)
+	})
+
+	p.RegisterEventHandler(func(events.BombPlantAborted) {
+		bt.site = 0
+	})
+
+	p.RegisterEventHandler(func(e events.BombPlanted) {
+		bt.site = rune(e.Site)
+	})
+
+	p.RegisterEventHandler(func(e events.BombDefuseStart) {
+		bt.defuser = e.Player
+	})
+
+	p.RegisterEventHandler(func(events.BombDefuseAborted) {
+		bt.defuser = nil
+	})
+
+	p.RegisterEventHandler(func(events.BombDefused) {
+		bt.defuser = nil
+	})
+
+	p.RegisterEventHandler(func(events.BombExplode) {
+		bt.defuser = nil
+	})
+
+	return bt
+}
+
+// bindEntity keeps *entity pointed to the latest entity of the server-class.
+func (bt *bombTracker) bindEntity(serverClass string, entity *st.Entity) {
+	sc := bt.parser.ServerClasses().FindByName(serverClass)
+	if sc == nil {
+		return
+	}
+
+	sc.OnEntityCreated(func(e st.Entity) {
+		*entity = e
+
+		e.OnDestroy(func() {
+			if *entity == e {
+				*entity = nil
+			}
+		})
+	})
+}
+
+// state returns the current bomb state or nil if there is no bomb.
+func (bt *bombTracker) state() *rep.Bomb {
+	if bt.c4 == nil && bt.planted == nil {
+		return nil
+	}
+
+	bomb := bt.parser.GameState().Bomb()
+
+	res := new(rep.Bomb)
+
+	if bomb.Carrier != nil {
+		res.CarrierID = bomb.Carrier.EntityID
+	} else {
+		res.Position = r3VectorToPointPtr(bomb.LastOnGroundPosition)
+	}
+
+	if bt.site != 0 {
+		res.Site = string(bt.site)
+	}
+
+	if bt.planted != nil {
+		res.IsPlanted = true
+
+		// The bomb's timers are in game time
+		now := float64(bt.parser.GameState().IngameTick()) * bt.parser.TickTime().Seconds()
+
+		// The planted bomb stays around after it has been defused or exploded
+		if ticking, ok := bt.planted.PropertyValue("m_bBombTicking"); ok && ticking.IntVal == 0 {
+			return res
+		}
+
+		if blow, ok := bt.planted.PropertyValue("m_flC4Blow"); ok {
+			res.TimeToExplosion = float32(roundTo(math.Max(0, float64(blow.FloatVal)-now), 0.1))
+		}
+
+		if bt.defuser != nil {
+			res.DefuserID = bt.defuser.EntityID
+			res.DefuseProgress = bt.defuseProgress(now)
+		}
+	}
+
+	return res
+}
+
+func (bt *bombTracker) defuseProgress(now float64) float32 {
+	countDown, okCountDown := bt.planted.PropertyValue("m_flDefuseCountDown")
+	length, okLength := bt.planted.PropertyValue("m_flDefuseLength")
+
+	if !okCountDown || !okLength || length.FloatVal <= 0 {
+		return 0
+	}
+
+	progress := 1 - (float64(countDown.FloatVal)-now)/float64(length.FloatVal)
+
+	return float32(roundTo(math.Min(1, math.Max(0, progress)), 0.01))
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 
 	demoinfocs "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
 	msgpack "gopkg.in/vmihailenco/msgpack.v2"
@@ -22,14 +26,27 @@ func main() {
 		fl.PrintDefaults()
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr, "May exit with code 3 if a demo ends unexpectedly, but the minified data may still be usable if this happens")
+		fmt.Fprintln(os.Stderr, "Exits with code 4 if the timeout is exceeded")
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr, "Direct bug reports and feature requests to https://github.com/markus-wa/cs-demo-minifier")
 	}
 
 	formatPtr := fl.String("format", "json", "Format into which the demo should me minified [json, msgpack, protobuf]")
 	freqPtr := fl.Float64("freq", 0.5, "Snapshot frequency - per second")
+	posFreqPtr := fl.Float64("posfreq", 0, "Position sampling frequency - per second (default one per snapshot)")
+	projFreqPtr := fl.Float64("projfreq", 0, "Grenade trajectory sampling frequency - per second (default every tick)")
 	demPathPtr := fl.String("demo", "", "Demo file `path` (default stdin)")
 	outPathPtr := fl.String("out", "", "Output file `path` (default stdout)")
+	streamPtr := fl.Bool("stream", false, "Write the replay while parsing instead of keeping it in memory (json & msgpack are written as a sequence of records)")
+	progressPtr := fl.Bool("progress", false, "Show a progress bar on stderr")
+	timeoutPtr := fl.Duration("timeout", 0, "Abort minification after this `duration` (e.g. 2m30s, default no timeout)")
+	startTickPtr := fl.Int("starttick", 0, "Only record snapshots & events from this `tick` on")
+	endTickPtr := fl.Int("endtick", 0, "Only record snapshots & events up to this `tick` (default until the end)")
+	skipWarmupPtr := fl.Bool("skipwarmup", false, "Don't record snapshots & events during warmup")
+	warningsPtr := fl.Bool("warnings", false, "Print parser warnings to stderr")
+
+	var rounds intList
+	fl.Var(&rounds, "rounds", "Only record snapshots & events of these comma separated round `numbers` (e.g. 1,2,16)")
 
 	err := fl.Parse(os.Args[1:])
 	if err != nil {
@@ -38,27 +55,104 @@ func main() {
 	}
 
 	format := *formatPtr
-	freq := *freqPtr
 	demPath := *demPathPtr
 	outPath := *outPathPtr
 
-	err = minify(demPath, freq, format, outPath)
+	cfg := min.DefaultReplayConfig(*freqPtr)
+	cfg.PositionSamplingFrequency = *posFreqPtr
+	cfg.ProjectileSamplingFrequency = *projFreqPtr
+	cfg.StartTick = *startTickPtr
+	cfg.EndTick = *endTickPtr
+	cfg.Rounds = rounds
+	cfg.SkipWarmup = *skipWarmupPtr
+
+	if *progressPtr {
+		cfg.ProgressHandler = printProgress
+	}
+
+	if *warningsPtr {
+		cfg.WarningHandler = printWarning
+	}
+
+	ctx := context.Background()
+
+	if *timeoutPtr > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, *timeoutPtr)
+		defer cancel()
+	}
+
+	err = minify(ctx, demPath, cfg, format, *streamPtr, outPath)
+
+	if *progressPtr {
+		// End the progress bar line
+		fmt.Fprintln(os.Stderr)
+	}
+
 	if err == demoinfocs.ErrUnexpectedEndOfDemo {
 		fmt.Fprintln(os.Stderr, "WARNING: encountered unexpected end of demo, but the minified data may still be usable")
 		os.Exit(3)
+	} else if err == context.DeadlineExceeded {
+		fmt.Fprintf(os.Stderr, "ERROR: minification took longer than %s\n", *timeoutPtr)
+		os.Exit(4)
 	} else if err != nil {
 		panic(err)
 	}
 }
 
-func minify(demPath string, freq float64, format string, outPath string) error {
-	var marshaller min.ReplayMarshaller
+// intList is a flag.Value for comma separated integers
+type intList []int
+
+func (l *intList) String() string {
+	strs := make([]string, len(*l))
+	for i, v := range *l {
+		strs[i] = strconv.Itoa(v)
+	}
+
+	return strings.Join(strs, ",")
+}
+
+func (l *intList) Set(value string) error {
+	for _, str := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(str))
+		if err != nil {
+			return err
+		}
+
+		*l = append(*l, v)
+	}
+
+	return nil
+}
+
+func printWarning(w rep.Warning) {
+	fmt.Fprintf(os.Stderr, "WARNING: %s (tick %d)\n", w.Message, w.Tick)
+}
+
+func printProgress(p min.Progress) {
+	const width = 50
+
+	done := int(p.Fraction * width)
+	if done > width {
+		done = width
+	}
+
+	fmt.Fprintf(os.Stderr, "\r[%s%s] %3d%%", strings.Repeat("=", done), strings.Repeat(" ", width-done), int(p.Fraction*100))
+}
+
+func minify(ctx context.Context, demPath string, cfg min.ReplayConfig, format string, stream bool, outPath string) error {
+	var (
+		marshaller min.ReplayMarshaller
+		newSink    func(io.Writer) min.ReplaySink
+	)
 
 	switch format {
 	case "json":
 		marshaller = func(replay rep.Replay, w io.Writer) error {
 			return json.NewEncoder(w).Encode(replay)
 		}
+		newSink = min.NewJSONStreamWriter
 
 	case "protobuf":
 		fallthrough
@@ -66,6 +160,9 @@ func minify(demPath string, freq float64, format string, outPath string) error {
 		fallthrough
 	case "pb":
 		marshaller = pb.MarshalReplay
+		newSink = func(w io.Writer) min.ReplaySink {
+			return pb.NewStreamWriter(w)
+		}
 
 	case "msgpack":
 		fallthrough
@@ -73,6 +170,7 @@ func minify(demPath string, freq float64, format string, outPath string) error {
 		marshaller = func(rep rep.Replay, w io.Writer) error {
 			return msgpack.NewEncoder(w).Encode(rep)
 		}
+		newSink = min.NewMsgPackStreamWriter
 
 	default:
 		fmt.Fprintf(os.Stderr, "Format '%s' unknown, known formats are 'json', 'msgpack' & 'protobuf'\n", format)
@@ -109,5 +207,16 @@ func minify(demPath string, freq float64, format string, outPath string) error {
 		}
 	}
 
-	return min.MinifyTo(in, freq, marshaller, out)
+	if stream {
+		bufOut := bufio.NewWriter(out)
+
+		err := min.MinifyToSinkWithContext(ctx, in, cfg, newSink(bufOut))
+		if flushErr := bufOut.Flush(); err == nil {
+			err = flushErr
+		}
+
+		return err
+	}
+
+	return min.MinifyToWithContext(ctx, in, cfg, marshaller, out)
 }
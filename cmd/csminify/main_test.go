@@ -62,6 +62,18 @@ func TestFreq(t *testing.T) {
 	runMainWithArgs([]string{"-demo", demPath, "-freq", "0.2", "-out", os.TempDir() + "/demo-freq.out"})
 }
 
+func TestPosFreq(t *testing.T) {
+	runMainWithArgs([]string{"-demo", demPath, "-freq", "0.2", "-posfreq", "8", "-out", os.TempDir() + "/demo-posfreq.out"})
+}
+
+func TestProgress(t *testing.T) {
+	runMainWithArgs([]string{"-demo", demPath, "-progress", "-timeout", "10m", "-out", os.TempDir() + "/demo-progress.out"})
+}
+
+func TestSelection(t *testing.T) {
+	runMainWithArgs([]string{"-demo", demPath, "-starttick", "1000", "-endtick", "50000", "-rounds", "1,2", "-skipwarmup", "-out", os.TempDir() + "/demo-selection.out"})
+}
+
 func TestJSON(t *testing.T) {
 	testFormat("json", ".json", t)
 }
@@ -74,6 +86,14 @@ func TestProtobuf(t *testing.T) {
 	testFormat("protobuf", ".pb", t)
 }
 
+func TestStream(t *testing.T) {
+	for format, suffix := range map[string]string{"json": ".json", "msgpack": ".mp", "protobuf": ".pb"} {
+		out := outPath + ".stream" + suffix
+		runMainWithArgs([]string{"-demo", demPath, "-format", format, "-stream", "-out", out})
+		assertOutFileCreated(out, t)
+	}
+}
+
 func testFormat(format string, suffix string, t *testing.T) {
 	runMainWithArgs([]string{"-demo", demPath, "-format", format, "-out", outPath + suffix})
 	assertOutFileCreated(outPath+suffix, t)
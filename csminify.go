@@ -4,17 +4,28 @@ package csminify
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
+	"sort"
 
 	r3 "github.com/golang/geo/r3"
 	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
 	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
 	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
 
 	rep "github.com/markus-wa/cs-demo-minifier/replay"
 )
 
+// Version is the version of the minifier that is recorded in replay headers.
+// Set it at build time via -ldflags "-X github.com/markus-wa/cs-demo-minifier.Version=<version>".
+var Version = "dev"
+
 // ReplayMarshaller is the signature for functions that serialize replay.Replay structs to an io.Writer
 type ReplayMarshaller func(rep.Replay, io.Writer) error
 
@@ -44,7 +55,13 @@ func MinifyTo(r io.Reader, snapFreq float64, marshal ReplayMarshaller, w io.Writ
 // MinifyToWithConfig reads a demo from r, creates a replay and marshals it to w.
 // See also: ToReplayWithConfig
 func MinifyToWithConfig(r io.Reader, cfg ReplayConfig, marshal ReplayMarshaller, w io.Writer) error {
-	replay, err := ToReplayWithConfig(r, cfg)
+	return MinifyToWithContext(context.Background(), r, cfg, marshal, w)
+}
+
+// MinifyToWithContext is like MinifyToWithConfig but stops parsing and returns ctx.Err() once ctx is done.
+// Nothing is written to w in that case.
+func MinifyToWithContext(ctx context.Context, r io.Reader, cfg ReplayConfig, marshal ReplayMarshaller, w io.Writer) error {
+	replay, err := ToReplayWithContext(ctx, r, cfg)
 
 	if err == dem.ErrUnexpectedEndOfDemo {
 		err = marshal(replay, w)
@@ -78,7 +95,48 @@ var DefaultReplayConfig = func(snapFreq float64) ReplayConfig {
 type ReplayConfig struct {
 	SnapshotFrequency float64
 	EventCollector    *EventCollector
-	// TODO: Smoothify flag?
+
+	// PositionSamplingFrequency is the number of player positions recorded per second (0 = one per snapshot).
+	// Positions sampled between two snapshots are added to EntityUpdate.Positions of the following snapshot,
+	// this allows smooth movement with low snapshot frequencies.
+	PositionSamplingFrequency float64
+
+	// ProjectileSamplingFrequency is the number of grenade projectile positions recorded per second (0 = every tick).
+	ProjectileSamplingFrequency float64
+
+	// DeltaSnapshots enables delta-encoding of snapshots, only fields that changed since the last snapshot are recorded.
+	// Full snapshots (keyframes) are still taken at the start of each round and every KeyframeInterval snapshots.
+	// Use replay.SnapshotDecoder or replay.FullSnapshots to reconstruct the full state.
+	DeltaSnapshots bool
+	// KeyframeInterval is the number of snapshots from one keyframe to the next, e.g. 10 makes every 10th snapshot a keyframe (0 = only at round start).
+	KeyframeInterval int
+
+	// WarningHandler is called for every non-fatal problem encountered while parsing the demo (optional).
+	WarningHandler func(rep.Warning)
+	// RecordWarnings enables storing parser warnings in Replay.Warnings.
+	RecordWarnings bool
+
+	// ProgressHandler is called whenever parsing has progressed by at least one percent (optional).
+	ProgressHandler func(Progress)
+
+	// StartTick and EndTick limit the recording of snapshots and events to a range of demo ticks, inclusive (0 = unlimited).
+	// The game state is still tracked outside of the selection.
+	StartTick int
+	EndTick   int
+	// Rounds limits the recording to the given round numbers (starting at 1), all rounds are recorded if empty.
+	// Warmup rounds aren't numbered, so they are never part of the selection.
+	Rounds []int
+	// SkipWarmup disables recording during the warmup period.
+	SkipWarmup bool
+
+	// PlayerFilter restricts entities, snapshots and events to a set of players (optional).
+	PlayerFilter *PlayerFilter
+}
+
+// Progress contains information about how much of a demo has been minified.
+type Progress struct {
+	Fraction float32 // Between 0 and 1, based on the number of frames in the demo header
+	Tick     int     // The current demo tick / frame
 }
 
 // ToReplay reads a demo from r, takes snapshots (snapFreq/sec) and records events into a Replay.
@@ -88,77 +146,368 @@ func ToReplay(r io.Reader, snapFreq float64) (rep.Replay, error) {
 
 // ToReplayWithConfig reads a demo from r, takes snapshots and records events into a Replay with a custom configuration.
 func ToReplayWithConfig(r io.Reader, cfg ReplayConfig) (rep.Replay, error) {
-	// TODO: Provide a way to pass on warnings to the caller
-	p := dem.NewParser(r)
+	return ToReplayWithContext(context.Background(), r, cfg)
+}
+
+// ToReplayWithContext is like ToReplayWithConfig but stops parsing and returns ctx.Err() once ctx is done.
+// The returned replay contains everything recorded up to that point, except for the footer.
+func ToReplayWithContext(ctx context.Context, r io.Reader, cfg ReplayConfig) (rep.Replay, error) {
+	collector := new(replayCollector)
+	err := MinifyToSinkWithContext(ctx, r, cfg, collector)
+
+	return collector.replay, err
+}
+
+// MinifyToSink reads a demo from r and passes all parts of the replay to sink as soon as they are available.
+// Unlike ToReplayWithConfig this doesn't keep the whole replay in memory.
+// Parsing is stopped if sink returns an error.
+func MinifyToSink(r io.Reader, cfg ReplayConfig, sink ReplaySink) error {
+	return MinifyToSinkWithContext(context.Background(), r, cfg, sink)
+}
+
+// MinifyToSinkWithContext is like MinifyToSink but stops parsing and returns ctx.Err() once ctx is done.
+// The footer isn't passed to sink in that case.
+func MinifyToSinkWithContext(ctx context.Context, r io.Reader, cfg ReplayConfig, sink ReplaySink) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Calculate the checksum of the demo while parsing
+	hash := sha256.New()
+	in := io.TeeReader(r, hash)
+
+	p := dem.NewParser(in)
 	header, err := p.ParseHeader()
 
 	if err != nil {
-		return rep.Replay{}, err
+		return err
 	}
 
 	// Make the parser accessible for the custom event handlers
 	cfg.EventCollector.parser = p
-
-	m := newMinifier(p, cfg.EventCollector, cfg.SnapshotFrequency)
-
-	m.replay.Header.MapName = header.MapName
+	cfg.EventCollector.playerFilter = cfg.PlayerFilter
+
+	m := newMinifier(ctx, p, cfg, sink)
+	m.bomb = newBombTracker(p)
+	m.items = newItemTracker(p)
+
+	m.header.MapName = header.MapName
+	m.header.ServerName = header.ServerName
+	m.header.ClientName = header.ClientName
+	m.header.PlaybackTime = header.PlaybackTime.Seconds()
+	m.header.PlaybackTicks = header.PlaybackTicks
+	m.header.PlaybackFrames = header.PlaybackFrames
+	m.header.NetworkProtocol = header.NetworkProtocol
+	m.header.Filestamp = header.Filestamp
+	m.header.MinifierVersion = Version
 	m.tickRate(p.TickRate())
 
+	// The rates are fixed once the header has been written, so they always match the recorded data
 	p.RegisterEventHandler(func(events.ConVarsUpdated) {
-		if tickRate := p.TickRate(); tickRate != 0 {
+		if tickRate := p.TickRate(); tickRate > 0 && !m.headerWritten {
 			m.tickRate(tickRate)
 		}
 	})
 
+	p.RegisterEventHandler(func(events.RoundStart) {
+		m.keyframePending = true
+
+		// The previous round might not have ended officially (e.g. after a restart)
+		m.finishRound()
+
+		// Warmup rounds don't count
+		if p.GameState().IsWarmupPeriod() {
+			m.round = 0
+			return
+		}
+
+		m.round = p.GameState().TotalRoundsPlayed() + 1
+		m.currentRound = &rep.Round{
+			Nr:        m.round,
+			StartTick: p.CurrentFrame(),
+		}
+	})
+
+	p.RegisterEventHandler(func(events.RoundFreezetimeEnd) {
+		if m.currentRound != nil {
+			m.currentRound.FreezeTimeEndTick = p.CurrentFrame()
+		}
+	})
+
+	p.RegisterEventHandler(func(e events.RoundEnd) {
+		if m.currentRound != nil {
+			m.currentRound.EndTick = p.CurrentFrame()
+			m.currentRound.Winner = int(e.Winner)
+			m.currentRound.Reason = int(e.Reason)
+		}
+	})
+
+	// The score is only updated after RoundEnd, so we wait for the official end of the round
+	p.RegisterEventHandler(func(events.RoundEndOfficial) {
+		m.finishRound()
+	})
+
+	p.RegisterEventHandler(func(events.BombPlanted) {
+		m.bombOutcome(rep.BombOutcomePlanted)
+	})
+
+	p.RegisterEventHandler(func(events.BombDefused) {
+		m.bombOutcome(rep.BombOutcomeDefused)
+	})
+
+	p.RegisterEventHandler(func(events.BombExplode) {
+		m.bombOutcome(rep.BombOutcomeExploded)
+	})
+
+	p.RegisterEventHandler(func(events.TeamSideSwitch) {
+		m.switchSides()
+	})
+
+	p.RegisterEventHandler(func(events.ScoreUpdated) {
+		m.scoreChanged = true
+	})
+
+	// Update the entities right away so join & leave ticks are accurate
+	playersChanged := func() {
+		m.playersChanged = true
+	}
+
+	p.RegisterEventHandler(func(events.PlayerConnect) { playersChanged() })
+	p.RegisterEventHandler(func(events.PlayerDisconnected) { playersChanged() })
+	p.RegisterEventHandler(func(events.PlayerNameChange) { playersChanged() })
+	p.RegisterEventHandler(func(events.PlayerTeamChange) { playersChanged() })
+
+	if cfg.WarningHandler != nil || cfg.RecordWarnings {
+		p.RegisterEventHandler(func(e events.ParserWarn) {
+			w := rep.Warning{
+				Tick:    p.CurrentFrame(),
+				Type:    int(e.Type),
+				Message: e.Message,
+			}
+
+			if cfg.WarningHandler != nil {
+				cfg.WarningHandler(w)
+			}
+
+			if cfg.RecordWarnings {
+				m.warning(w)
+			}
+		})
+	}
+
 	// Register event handlers from collector
 	for _, h := range cfg.EventCollector.handlers {
 		m.parser.RegisterEventHandler(h)
 	}
 
+	m.registerProjectileHandlers()
 	m.parser.RegisterEventHandler(m.frameDone)
 
 	err = p.ParseToEnd()
 
-	return m.replay, err
+	// The last round of a match doesn't end officially
+	m.finishRound()
+	m.flushProjectiles()
+
+	if m.err != nil {
+		return m.err
+	}
+
+	// Demos without any frames
+	if headerErr := m.writeHeader(); headerErr != nil {
+		return headerErr
+	}
+
+	m.updateTeams()
+
+	// Include everything after the end of the demo in the checksum
+	if _, copyErr := io.Copy(ioutil.Discard, in); copyErr == nil {
+		m.footer.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	if footerErr := sink.Footer(m.footer); footerErr != nil {
+		return footerErr
+	}
+
+	return err
 }
 
 type minifier struct {
+	ctx               context.Context
 	parser            dem.Parser
-	replay            rep.Replay
+	header            rep.Header
+	headerWritten     bool
+	pendingWarnings   []rep.Warning // Warnings that occurred before the header was written
+	footer            rep.Footer
+	sink              ReplaySink
+	err               error // The error that caused parsing to be aborted
 	eventCollector    *EventCollector
 	snapshotFrequency float64
-
-	knownPlayerEntityIDs map[int]struct{}
+	deltaSnapshots    bool
+	keyframeInterval  int
+
+	knownEntities   map[int]rep.Entity // The current state of each connected player by entity-ID, regardless of selection & filter
+	writtenEntities map[int]rep.Entity // The latest record written to the sink by entity-ID
+	playersChanged  bool               // Set when players might have joined, left or changed since the last update
+	playerFilter    *PlayerFilter
+
+	progressHandler     func(Progress)
+	lastProgressPercent int
+
+	positionSamplingFrequency float64
+	positionSamples           map[int][]rep.Point // Positions sampled since the last snapshot by entity-ID
+
+	projectileSamplingFrequency float64
+	projectiles                 map[int]*flyingProjectile // Grenades that haven't detonated yet by entity-ID
+
+	// Tick & round selection
+	startTick  int
+	endTick    int
+	rounds     map[int]struct{}
+	skipWarmup bool
+	round      int // The current round number, 0 before the first round started & during warmup
+	recording  bool
+
+	bomb  *bombTracker
+	items *itemTracker
+
+	// Round tracking
+	currentRound         *rep.Round // nil outside of rounds
+	currentRoundRecorded bool       // Whether any tick of the current round has been recorded
+
+	// Team & score tracking
+	teams        []rep.Team // The team that started as terrorists first
+	scoreChanged bool       // Set when the score has changed during the current frame
+
+	// Delta-encoding state
+	lastEntityStates       map[int]rep.EntityUpdate // nil until the first keyframe has been taken
+	snapshotsSinceKeyframe int                      // Including the current snapshot, 0 on keyframes
+	keyframePending        bool
 }
 
-func newMinifier(parser dem.Parser, eventCollector *EventCollector, snapshotFrequency float64) minifier {
+func newMinifier(ctx context.Context, parser dem.Parser, cfg ReplayConfig, sink ReplaySink) minifier {
+	var rounds map[int]struct{}
+	if len(cfg.Rounds) > 0 {
+		rounds = make(map[int]struct{}, len(cfg.Rounds))
+		for _, nr := range cfg.Rounds {
+			rounds[nr] = struct{}{}
+		}
+	}
+
 	return minifier{
-		parser:               parser,
-		eventCollector:       eventCollector,
-		knownPlayerEntityIDs: make(map[int]struct{}),
-		snapshotFrequency:    snapshotFrequency,
+		ctx:               ctx,
+		parser:            parser,
+		sink:              sink,
+		eventCollector:    cfg.EventCollector,
+		knownEntities:     make(map[int]rep.Entity),
+		writtenEntities:   make(map[int]rep.Entity),
+		playerFilter:      cfg.PlayerFilter,
+		snapshotFrequency: cfg.SnapshotFrequency,
+		deltaSnapshots:    cfg.DeltaSnapshots,
+		keyframeInterval:  cfg.KeyframeInterval,
+		progressHandler:   cfg.ProgressHandler,
+
+		positionSamplingFrequency: cfg.PositionSamplingFrequency,
+		positionSamples:           make(map[int][]rep.Point),
+
+		projectileSamplingFrequency: cfg.ProjectileSamplingFrequency,
+		projectiles:                 make(map[int]*flyingProjectile),
+
+		teams: []rep.Team{
+			{Sides: []int{int(common.TeamTerrorists)}},
+			{Sides: []int{int(common.TeamCounterTerrorists)}},
+		},
+
+		startTick:  cfg.StartTick,
+		endTick:    cfg.EndTick,
+		rounds:     rounds,
+		skipWarmup: cfg.SkipWarmup,
 	}
 }
 
 func (m *minifier) frameDone(events.FrameDone) {
+	if err := m.ctx.Err(); err != nil {
+		m.abort(err)
+		return
+	}
+
+	if !m.headerWritten {
+		// The server's tick rate is usually known after the first frame, before that only the demo header's (if any)
+		if tickRate := m.parser.TickRate(); tickRate > 0 {
+			m.tickRate(tickRate)
+		}
+
+		if m.header.TickRate <= 0 {
+			// Nothing can be recorded without knowing the tick rate
+			m.eventCollector.events = m.eventCollector.events[:0]
+			return
+		}
+
+		m.abort(m.writeHeader())
+	}
+
 	tick := m.parser.CurrentFrame()
-	// Is it snapshot o'clock?
-	if tick%m.replay.Header.SnapshotRate == 0 {
-		// TODO: There might be a better way to do this than having updateKnownPlayers() here
-		m.updateKnownPlayers()
+	m.reportProgress(tick)
+
+	if m.scoreChanged {
+		// Both sides are updated at the end of the frame, so we record the score only once per frame
+		m.scoreChanged = false
+		m.updateScore(tick)
+	}
+
+	// Projectiles are followed until they detonate, even outside of the selection
+	m.sampleProjectiles(tick)
+
+	recording := m.isSelected(tick)
+	if recording && !m.recording {
+		// Start off with a full snapshot after a gap in the recording
+		m.keyframePending = true
+		m.positionSamples = make(map[int][]rep.Point)
+		m.playersChanged = true
+	}
+
+	m.recording = recording
+
+	if recording && m.currentRound != nil {
+		m.currentRoundRecorded = true
+	}
+
+	isSnapshotTick := tick%m.header.SnapshotRate == 0
 
+	// Players are tracked outside of the selection as well to get their join & leave ticks right.
+	// Entity IDs are sometimes only assigned after the connect event, so we check again on every snapshot
+	if m.playersChanged || isSnapshotTick {
+		m.playersChanged = false
+		m.updateKnownPlayers(tick)
+	}
+
+	if !recording {
+		// Keep tracking the game state but discard everything outside of the selection
+		m.eventCollector.events = m.eventCollector.events[:0]
+		return
+	}
+
+	// Is it snapshot o'clock?
+	if isSnapshotTick {
 		snap := m.snapshot()
-		m.replay.Snapshots = append(m.replay.Snapshots, snap)
+		if m.deltaSnapshots {
+			snap = m.deltaEncode(snap)
+		}
+
+		m.abort(m.sink.Snapshot(snap))
+	} else if rate := m.header.PositionSampleRate; rate > 0 && tick%rate == 0 {
+		// Samples are taken on every multiple of the sample rate, snapshots contain the position at their tick anyway
+		m.samplePositions()
 	}
 
 	// Did we collect any events in this frame?
 	if len(m.eventCollector.events) > 0 {
 		tickEvents := make([]rep.Event, len(m.eventCollector.events))
 		copy(tickEvents, m.eventCollector.events)
-		m.replay.Ticks = append(m.replay.Ticks, rep.Tick{
+		m.abort(m.sink.Tick(rep.Tick{
 			Nr:     tick,
 			Events: tickEvents,
-		})
+		}))
 		// Clear events for next frame
 		m.eventCollector.events = m.eventCollector.events[:0]
 	}
@@ -166,77 +515,475 @@ func (m *minifier) frameDone(events.FrameDone) {
 
 func (m *minifier) snapshot() rep.Snapshot {
 	snap := rep.Snapshot{
-		Tick: m.parser.CurrentFrame(),
+		Tick:     m.parser.CurrentFrame(),
+		Bomb:     m.bomb.state(),
+		Infernos: m.infernos(),
+		Items:    m.items.state(),
+		Hostages: m.hostages(),
 	}
 
 	for _, pl := range m.parser.GameState().Participants().Playing() {
-		if pl.IsAlive() {
+		if pl.IsAlive() && m.includes(pl) {
 			e := rep.EntityUpdate{
 				EntityID:      pl.EntityID,
 				Hp:            pl.Health(),
 				Armor:         pl.Armor(),
 				FlashDuration: float32(roundTo(float64(pl.FlashDuration), 0.1)), // Round to nearest 0.1 sec - saves space in JSON
-				Positions:     []rep.Point{r3VectorToPoint(pl.Position())},
+				Positions:     append(m.positionSamples[pl.EntityID], r3VectorToPoint(pl.Position())),
 				AngleX:        int(pl.ViewDirectionX()),
 				AngleY:        int(pl.ViewDirectionY()),
 				HasHelmet:     pl.HasHelmet(),
 				HasDefuseKit:  pl.HasDefuseKit(),
-				Equipment:     toEntityEquipment(pl.Weapons()),
 				Team:          int(pl.Team),
+				Economy:       toEntityEconomy(pl),
+				Stats:         toEntityStats(pl),
+				Velocity:      r3VectorToPointPtr(pl.Velocity()),
+				IsDucking:     pl.IsDucking(),
+				IsWalking:     pl.IsWalking(),
+				IsAirborne:    pl.IsAirborne(),
+				IsScoped:      pl.IsScoped(),
+				IsDefusing:    pl.IsDefusing,
+				IsPlanting:    pl.IsPlanting,
+				IsReloading:   pl.IsReloading,
 			}
 
-			// FIXME: Smoothify Positions
+			var activeIndex int
+
+			e.Equipment, activeIndex = toEntityEquipment(pl.Weapons(), pl.ActiveWeapon())
+			if activeIndex >= 0 {
+				e.ActiveWeapon = e.Equipment[activeIndex].Type
+				e.ActiveWeaponIndex = activeIndex
+			}
 
 			snap.EntityUpdates = append(snap.EntityUpdates, e)
 		}
 	}
 
+	// Start collecting samples for the next snapshot
+	m.positionSamples = make(map[int][]rep.Point)
+
 	return snap
 }
 
-func (m *minifier) updateKnownPlayers() {
-	for _, pl := range m.parser.GameState().Participants().All() {
+func (m *minifier) samplePositions() {
+	for _, pl := range m.parser.GameState().Participants().Playing() {
+		if pl.IsAlive() && m.includes(pl) {
+			m.positionSamples[pl.EntityID] = append(m.positionSamples[pl.EntityID], r3VectorToPoint(pl.Position()))
+		}
+	}
+}
+
+// deltaEncode turns a full snapshot into a delta snapshot unless it's time for a keyframe.
+func (m *minifier) deltaEncode(snap rep.Snapshot) rep.Snapshot {
+	states := make(map[int]rep.EntityUpdate, len(snap.EntityUpdates))
+	for _, u := range snap.EntityUpdates {
+		states[u.EntityID] = u
+	}
+
+	prevStates := m.lastEntityStates
+	m.lastEntityStates = states
+
+	m.snapshotsSinceKeyframe++
+
+	isKeyframe := prevStates == nil || m.keyframePending ||
+		(m.keyframeInterval > 0 && m.snapshotsSinceKeyframe >= m.keyframeInterval)
+	if isKeyframe {
+		m.keyframePending = false
+		m.snapshotsSinceKeyframe = 0
+
+		return snap
+	}
+
+	delta := rep.Snapshot{
+		Tick:     snap.Tick,
+		Delta:    true,
+		Bomb:     snap.Bomb,
+		Infernos: snap.Infernos,
+		Items:    snap.Items,
+		Hostages: snap.Hostages,
+	}
+
+	for _, u := range snap.EntityUpdates {
+		// New entities are compared to the zero value, so all their fields are recorded
+		prev := prevStates[u.EntityID]
+
+		if d, changed := rep.DeltaEntityUpdate(prev, u); changed {
+			delta.EntityUpdates = append(delta.EntityUpdates, d)
+		}
+	}
+
+	for id := range prevStates {
+		if _, stillAlive := states[id]; !stillAlive {
+			delta.RemovedEntityIDs = append(delta.RemovedEntityIDs, id)
+		}
+	}
+
+	sort.Ints(delta.RemovedEntityIDs)
+
+	return delta
+}
+
+// finishRound passes the current round to the sink if it has been recorded.
+func (m *minifier) finishRound() {
+	// Don't write to a sink that already failed
+	if m.currentRound == nil || m.err != nil {
+		return
+	}
+
+	round := *m.currentRound
+	recorded := m.currentRoundRecorded
+
+	m.currentRound = nil
+	m.currentRoundRecorded = false
+
+	if !recorded {
+		return
+	}
+
+	gs := m.parser.GameState()
+	round.ScoreTerrorists = gs.TeamTerrorists().Score()
+	round.ScoreCounterTerrorists = gs.TeamCounterTerrorists().Score()
+
+	m.abort(m.sink.Round(round))
+}
+
+func (m *minifier) bombOutcome(outcome string) {
+	if m.currentRound != nil {
+		m.currentRound.BombOutcome = outcome
+	}
+}
+
+// switchSides records that the teams have switched sides.
+func (m *minifier) switchSides() {
+	for i := range m.teams {
+		side := common.TeamTerrorists
+		if common.Team(m.teams[i].Sides[len(m.teams[i].Sides)-1]) == common.TeamTerrorists {
+			side = common.TeamCounterTerrorists
+		}
+
+		m.teams[i].Sides = append(m.teams[i].Sides, int(side))
+	}
+}
+
+// updateTeams sets the team table of the footer from the current game state.
+func (m *minifier) updateTeams() {
+	for i := range m.teams {
+		currentSide := common.Team(m.teams[i].Sides[len(m.teams[i].Sides)-1])
+
+		if state := m.parser.GameState().Team(currentSide); state != nil {
+			m.teams[i].ClanName = state.ClanName()
+			m.teams[i].Flag = state.Flag()
+		}
+	}
+
+	m.footer.Teams = m.teams
+}
+
+func (m *minifier) updateScore(tick int) {
+	gs := m.parser.GameState()
+	score := rep.Score{
+		Tick:              tick,
+		Round:             m.round,
+		Terrorists:        gs.TeamTerrorists().Score(),
+		CounterTerrorists: gs.TeamCounterTerrorists().Score(),
+	}
+
+	if n := len(m.footer.Scores); n > 0 {
+		last := m.footer.Scores[n-1]
+		if last.Terrorists == score.Terrorists && last.CounterTerrorists == score.CounterTerrorists {
+			return
+		}
+	}
+
+	m.footer.Scores = append(m.footer.Scores, score)
+}
+
+// updateKnownPlayers tracks players that joined, left or changed since the last update
+// and writes their records if they are part of the replay.
+// Players are added to the replay if they pass the player filter inside the selection,
+// after that their records are kept up to date until they leave.
+func (m *minifier) updateKnownPlayers(tick int) {
+	connected := make(map[int]*common.Player)
+
+	for _, pl := range m.parser.GameState().Participants().Connected() {
 		if pl.EntityID != 0 {
-			if _, alreadyKnown := m.knownPlayerEntityIDs[pl.EntityID]; !alreadyKnown {
-				ent := rep.Entity{
-					ID:    pl.EntityID,
-					Team:  int(pl.Team),
-					Name:  pl.Name,
-					IsNpc: pl.IsBot,
-				}
+			connected[pl.EntityID] = pl
+		}
+	}
+
+	// Sort entity-IDs to keep the order of the records stable
+	knownIDs := make([]int, 0, len(m.knownEntities))
+	for id := range m.knownEntities {
+		knownIDs = append(knownIDs, id)
+	}
 
-				m.replay.Entities = append(m.replay.Entities, ent)
+	sort.Ints(knownIDs)
 
-				m.knownPlayerEntityIDs[pl.EntityID] = struct{}{}
-			}
+	// Players that left or whose entity-ID has been taken over by someone else
+	for _, id := range knownIDs {
+		if pl, ok := connected[id]; ok && isSamePlayer(m.knownEntities[id], pl) {
+			continue
+		}
+
+		if written, ok := m.writtenEntities[id]; ok {
+			written.Tick = tick
+			written.LeaveTick = tick
+
+			m.abort(m.sink.Entity(written))
+
+			delete(m.writtenEntities, id)
+		}
+
+		delete(m.knownEntities, id)
+	}
+
+	connectedIDs := make([]int, 0, len(connected))
+	for id := range connected {
+		connectedIDs = append(connectedIDs, id)
+	}
+
+	sort.Ints(connectedIDs)
+
+	for _, id := range connectedIDs {
+		pl := connected[id]
+		ent := m.toEntity(pl)
+
+		if known, alreadyKnown := m.knownEntities[id]; alreadyKnown {
+			ent.JoinTick = known.JoinTick
+		} else {
+			ent.JoinTick = tick
+		}
+
+		m.knownEntities[id] = ent
+
+		written, alreadyWritten := m.writtenEntities[id]
+		if !m.recording || !alreadyWritten && !m.includes(pl) {
+			continue
+		}
+
+		ent.Tick = written.Tick
+		if alreadyWritten && ent == written {
+			continue
+		}
+
+		ent.Tick = tick
+
+		m.abort(m.sink.Entity(ent))
+
+		m.writtenEntities[id] = ent
+	}
+}
+
+// isSamePlayer reports whether pl is the player of an existing entity record.
+// Bots don't have a Steam ID, so they are identified by their name.
+func isSamePlayer(ent rep.Entity, pl *common.Player) bool {
+	if ent.IsNpc || pl.IsBot {
+		return ent.IsNpc == pl.IsBot && ent.Name == pl.Name
+	}
+
+	return ent.SteamID64 == pl.SteamID64
+}
+
+func (m *minifier) toEntity(pl *common.Player) rep.Entity {
+	ent := rep.Entity{
+		ID:    pl.EntityID,
+		Team:  int(pl.Team),
+		Name:  pl.Name,
+		IsNpc: pl.IsBot,
+
+		SteamID64:     pl.SteamID64,
+		AccountID:     pl.SteamID32(),
+		ClanTag:       pl.ClanTag(),
+		CrosshairCode: pl.CrosshairCode(),
+	}
+
+	ent.Rank, ent.CompetitiveWins = competitiveRank(m.parser.GameState().PlayerResourceEntity(), pl)
+
+	return ent
+}
+
+// competitiveRank returns the player's competitive skill group and number of wins from the player resource.
+func competitiveRank(res st.Entity, pl *common.Player) (rank, wins int) {
+	if res == nil {
+		return 0, 0
+	}
+
+	idStr := fmt.Sprintf("%03d", pl.EntityID)
+
+	if val, ok := res.PropertyValue("m_iCompetitiveRanking." + idStr); ok {
+		rank = val.IntVal
+	}
+
+	if val, ok := res.PropertyValue("m_iCompetitiveWins." + idStr); ok {
+		wins = val.IntVal
+	}
+
+	return rank, wins
+}
+
+// includes reports whether the player passes the configured player filter.
+func (m *minifier) includes(pl *common.Player) bool {
+	return m.playerFilter == nil || m.playerFilter.Matches(pl)
+}
+
+// includesOwnedBy reports whether something owned by pl passes the configured player filter.
+// Like events, things that don't belong to any (known) player are included.
+func (m *minifier) includesOwnedBy(pl *common.Player) bool {
+	return pl == nil || m.includes(pl)
+}
+
+// isSelected reports whether the tick is inside the configured tick & round selection.
+func (m *minifier) isSelected(tick int) bool {
+	if tick < m.startTick || (m.endTick > 0 && tick > m.endTick) {
+		return false
+	}
+
+	if m.skipWarmup && m.parser.GameState().IsWarmupPeriod() {
+		return false
+	}
+
+	if m.rounds != nil {
+		_, selected := m.rounds[m.round]
+		return selected
+	}
+
+	return true
+}
+
+func (m *minifier) reportProgress(tick int) {
+	if m.progressHandler == nil {
+		return
+	}
+
+	fraction := m.parser.Progress()
+	if percent := int(fraction * 100); percent > m.lastProgressPercent {
+		m.lastProgressPercent = percent
+		m.progressHandler(Progress{
+			Fraction: fraction,
+			Tick:     tick,
+		})
+	}
+}
+
+// writeHeader passes the header to the sink, followed by the warnings that occurred before.
+// Does nothing if the header has already been written.
+func (m *minifier) writeHeader() error {
+	if m.headerWritten {
+		return nil
+	}
+
+	m.headerWritten = true
+
+	if err := m.sink.Header(m.header); err != nil {
+		return err
+	}
+
+	for _, w := range m.pendingWarnings {
+		if err := m.sink.Warning(w); err != nil {
+			return err
 		}
 	}
+
+	m.pendingWarnings = nil
+
+	return nil
+}
+
+// warning passes w to the sink, or queues it until the header has been written.
+func (m *minifier) warning(w rep.Warning) {
+	if !m.headerWritten {
+		m.pendingWarnings = append(m.pendingWarnings, w)
+		return
+	}
+
+	m.abort(m.sink.Warning(w))
+}
+
+// abort stops parsing if err isn't nil, only the first error is kept.
+func (m *minifier) abort(err error) {
+	if err != nil && m.err == nil {
+		m.err = err
+		m.parser.Cancel()
+	}
 }
 
 func (m *minifier) tickRate(rate float64) {
-	m.replay.Header.TickRate = rate
-	m.replay.Header.SnapshotRate = int(math.Round(rate / m.snapshotFrequency))
+	m.header.TickRate = rate
+	m.header.SnapshotRate = int(math.Round(rate / m.snapshotFrequency))
+
+	if m.positionSamplingFrequency > 0 {
+		m.header.PositionSampleRate = int(math.Max(1, math.Round(rate/m.positionSamplingFrequency)))
+	}
+
+	m.header.ProjectileSampleRate = 1
+	if m.projectileSamplingFrequency > 0 {
+		m.header.ProjectileSampleRate = int(math.Max(1, math.Round(rate/m.projectileSamplingFrequency)))
+	}
 }
 
 func r3VectorToPoint(v r3.Vector) rep.Point {
 	return rep.Point{X: int(v.X), Y: int(v.Y), Z: int(v.Z)}
 }
 
+func r3VectorToPointPtr(v r3.Vector) *rep.Point {
+	p := r3VectorToPoint(v)
+	return &p
+}
+
 // roundTo wraps math.Round and allows specifying the rounding precision.
 func roundTo(x, precision float64) float64 {
 	return math.Round(x/precision) * precision
 }
 
-func toEntityEquipment(eq []*common.Equipment) []rep.EntityEquipment {
-	var equipmentForPlayer = make([]rep.EntityEquipment, 0, len(eq))
+func toEntityEconomy(pl *common.Player) *rep.EntityEconomy {
+	eco := &rep.EntityEconomy{
+		Money:               pl.Money(),
+		EquipmentValue:      pl.EquipmentValueCurrent(),
+		MoneySpentThisRound: pl.MoneySpentThisRound(),
+	}
+
+	// Not exposed by common.Player, but sent by the game
+	if val, ok := pl.Entity.PropertyValue("m_iStartAccount"); ok {
+		eco.StartMoney = val.IntVal
+	}
+
+	return eco
+}
+
+func toEntityStats(pl *common.Player) *rep.EntityStats {
+	return &rep.EntityStats{
+		Kills:   pl.Kills(),
+		Deaths:  pl.Deaths(),
+		Assists: pl.Assists(),
+		MVPs:    pl.MVPs(),
+		Score:   pl.Score(),
+		Ping:    pl.Ping(),
+	}
+}
+
+// toEntityEquipment returns the equipment sorted by type and the index of the active weapon (-1 if not found).
+func toEntityEquipment(eq []*common.Equipment, active *common.Equipment) ([]rep.EntityEquipment, int) {
+	// Player.Weapons() is backed by a map, keep the order stable between snapshots
+	sorted := append([]*common.Equipment(nil), eq...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Type < sorted[j].Type
+	})
 
-	for _, equipment := range eq {
+	var equipmentForPlayer = make([]rep.EntityEquipment, 0, len(sorted))
+
+	activeIndex := -1
+
+	for i, equipment := range sorted {
 		equipmentForPlayer = append(equipmentForPlayer, rep.EntityEquipment{
 			Type:           int(equipment.Type),
 			AmmoInMagazine: equipment.AmmoInMagazine(),
 			AmmoReserve:    equipment.AmmoReserve(),
 		})
+
+		if equipment == active {
+			activeIndex = i
+		}
 	}
 
-	return equipmentForPlayer
+	return equipmentForPlayer, activeIndex
 }
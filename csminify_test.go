@@ -2,6 +2,9 @@ package csminify_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -11,9 +14,12 @@ import (
 	"gopkg.in/vmihailenco/msgpack.v2"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+
 	csminify "github.com/markus-wa/cs-demo-minifier"
 	rep "github.com/markus-wa/cs-demo-minifier/replay"
 	nondefaultrep "github.com/markus-wa/cs-demo-minifier/replay/nondefault"
@@ -156,6 +162,802 @@ func TestExtraHandlers(t *testing.T) {
 	}
 }
 
+func TestDeltaSnapshots(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.DeltaSnapshots = true
+	cfg.KeyframeInterval = 10
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	full := rep.FullSnapshots(r.Snapshots)
+	assert.Len(t, full, len(parsedReplay.Snapshots))
+
+	for i, s := range parsedReplay.Snapshots {
+		assert.Equal(t, s.Tick, full[i].Tick)
+		assert.Equal(t, sortedByEntityID(s.EntityUpdates), sortedByEntityID(full[i].EntityUpdates))
+	}
+
+	// Keyframes are taken every KeyframeInterval snapshots, or earlier at the start of a round
+	var sinceKeyframe, fullIntervals int
+
+	for _, s := range r.Snapshots {
+		if !s.Delta {
+			if sinceKeyframe == cfg.KeyframeInterval {
+				fullIntervals++
+			}
+
+			sinceKeyframe = 0
+		}
+
+		sinceKeyframe++
+
+		assert.True(t, sinceKeyframe <= cfg.KeyframeInterval, "no keyframe after %d snapshots", cfg.KeyframeInterval)
+	}
+
+	assert.NotZero(t, fullIntervals, "no keyframes exactly %d snapshots apart", cfg.KeyframeInterval)
+}
+
+func TestEconomy(t *testing.T) {
+	hasMoney := false
+
+	for _, s := range parsedReplay.Snapshots {
+		for _, u := range s.EntityUpdates {
+			if assert.NotNil(t, u.Economy) && u.Economy.Money > 0 {
+				hasMoney = true
+			}
+		}
+	}
+
+	assert.True(t, hasMoney, "no player ever had money")
+}
+
+func TestStats(t *testing.T) {
+	hasKills := false
+
+	for _, s := range parsedReplay.Snapshots {
+		for _, u := range s.EntityUpdates {
+			if assert.NotNil(t, u.Stats) && u.Stats.Kills > 0 {
+				hasKills = true
+			}
+		}
+	}
+
+	assert.True(t, hasKills, "no player ever had kills")
+}
+
+func TestMovement(t *testing.T) {
+	var moving, ducking bool
+
+	for _, s := range parsedReplay.Snapshots {
+		for _, u := range s.EntityUpdates {
+			if assert.NotNil(t, u.Velocity) && *u.Velocity != (rep.Point{}) {
+				moving = true
+			}
+
+			ducking = ducking || u.IsDucking
+		}
+	}
+
+	assert.True(t, moving, "no player ever moved")
+	assert.True(t, ducking, "no player ever ducked")
+}
+
+func TestActiveWeapon(t *testing.T) {
+	for _, s := range parsedReplay.Snapshots {
+		for _, u := range s.EntityUpdates {
+			if u.ActiveWeapon != 0 {
+				assert.Equal(t, u.ActiveWeapon, u.Equipment[u.ActiveWeaponIndex].Type)
+			}
+		}
+	}
+
+	assert.True(t, containsEvent(parsedReplay, rep.EventWeaponSwitch), "no weapon_switch events")
+}
+
+func TestBomb(t *testing.T) {
+	var carried, planted bool
+
+	for _, s := range parsedReplay.Snapshots {
+		if s.Bomb == nil {
+			continue
+		}
+
+		if s.Bomb.CarrierID != 0 {
+			carried = true
+			assert.Nil(t, s.Bomb.Position)
+		} else {
+			assert.NotNil(t, s.Bomb.Position)
+		}
+
+		if s.Bomb.IsPlanted {
+			planted = true
+			assert.NotEmpty(t, s.Bomb.Site)
+		}
+	}
+
+	assert.True(t, carried, "bomb was never carried")
+	assert.True(t, planted, "bomb was never planted")
+}
+
+// Test that the bomb site is reset when a plant is aborted.
+func TestBomb_PlantAborted(t *testing.T) {
+	aborted := make(map[int]bool) // Tick -> whether the last plant before it was aborted
+
+	var ticks []int
+
+	isAborted := false
+	for _, tick := range parsedReplay.Ticks {
+		for _, e := range tick.Events {
+			switch e.Name {
+			case rep.EventBombPlantAborted:
+				isAborted = true
+			case rep.EventBombPlantBegin, rep.EventBombPlanted:
+				isAborted = false
+			}
+		}
+
+		aborted[tick.Nr] = isAborted
+		ticks = append(ticks, tick.Nr)
+	}
+
+	for _, s := range parsedReplay.Snapshots {
+		if s.Bomb == nil || s.Bomb.IsPlanted {
+			continue
+		}
+
+		// Find the latest event tick before the snapshot
+		i := sort.SearchInts(ticks, s.Tick+1) - 1
+		if i >= 0 && aborted[ticks[i]] {
+			assert.Empty(t, s.Bomb.Site, "bomb site after aborted plant at tick %d", s.Tick)
+		}
+	}
+}
+
+func TestBombEvents(t *testing.T) {
+	for _, name := range []string{rep.EventBombPickup, rep.EventBombDropped, rep.EventBombPlantBegin, rep.EventBombPlanted} {
+		assert.True(t, containsEvent(parsedReplay, name), "no %s events", name)
+	}
+}
+
+func TestProjectiles(t *testing.T) {
+	assert.NotEmpty(t, parsedReplay.Projectiles, "no projectiles")
+	assert.Equal(t, 1, parsedReplay.Header.ProjectileSampleRate)
+
+	for _, p := range parsedReplay.Projectiles {
+		assert.NotZero(t, p.Type)
+		assert.NotEmpty(t, p.Trajectory)
+
+		if p.DetonationTick != 0 {
+			assert.True(t, p.DetonationTick >= p.ThrowTick, "projectile detonated before it was thrown")
+			assert.LessOrEqual(t, len(p.Trajectory), p.DetonationTick-p.ThrowTick+1)
+		}
+	}
+}
+
+func TestGrenadeThrowEvents(t *testing.T) {
+	thrown := make(map[float64]rep.Event) // By projectile-ID
+	landed := 0
+
+	for _, tick := range parsedReplay.Ticks {
+		for _, e := range tick.Events {
+			switch e.Name {
+			case rep.EventGrenadeThrown:
+				for _, key := range []string{"x", "y", "z", "speed", "isAirborne", "isDucking", "isWalking"} {
+					assert.True(t, hasAttribute(e, key), "grenade_thrown without %s", key)
+				}
+
+				yaw, _ := numAttr(e, "yaw")
+				assert.True(t, yaw >= 0 && yaw <= 360, "yaw %f out of range", yaw)
+
+				pitch, _ := numAttr(e, "pitch")
+				assert.True(t, pitch >= -90 && pitch <= 90, "pitch %f out of range", pitch)
+
+				id, ok := numAttr(e, rep.AttrKindProjectileID)
+				if assert.True(t, ok, "grenade_thrown without projectile-ID") {
+					thrown[id] = e
+				}
+
+			case rep.EventGrenadeLanded:
+				landed++
+
+				for _, key := range []string{"x", "y", "z"} {
+					assert.True(t, hasAttribute(e, key), "grenade_landed without %s", key)
+				}
+
+				id, _ := numAttr(e, rep.AttrKindProjectileID)
+				throw, ok := thrown[id]
+				if !assert.True(t, ok, "grenade_landed of projectile %v without grenade_thrown", id) {
+					continue
+				}
+
+				delete(thrown, id)
+
+				for _, key := range []string{rep.AttrKindEntityID, rep.AttrKindWeapon} {
+					throwVal, _ := numAttr(throw, key)
+					landVal, _ := numAttr(e, key)
+					assert.Equal(t, throwVal, landVal, "%s of projectile %v", key, id)
+				}
+			}
+		}
+	}
+
+	assert.NotZero(t, landed, "no grenade_landed events")
+}
+
+func TestInfernos(t *testing.T) {
+	var burning bool
+
+	for _, s := range parsedReplay.Snapshots {
+		for _, inf := range s.Infernos {
+			burning = true
+
+			assert.NotEmpty(t, inf.Fires)
+			assert.NotZero(t, inf.ThrowerID)
+		}
+	}
+
+	assert.True(t, burning, "no burning infernos")
+
+	for _, tick := range parsedReplay.Ticks {
+		for _, e := range tick.Events {
+			if e.Name == rep.EventFireGrenadeStart {
+				assert.True(t, hasAttribute(e, rep.AttrKindThrowerID), "fire_grenade_started without thrower")
+			}
+		}
+	}
+}
+
+func TestItems(t *testing.T) {
+	var dropped bool
+
+	for _, s := range parsedReplay.Snapshots {
+		for _, item := range s.Items {
+			dropped = true
+
+			assert.NotZero(t, item.Type)
+			assert.NotEqual(t, int(common.EqBomb), item.Type, "the bomb should only be part of Snapshot.Bomb")
+		}
+	}
+
+	assert.True(t, dropped, "no items on the ground")
+	assert.True(t, containsEvent(parsedReplay, rep.EventItemPickup), "no item_pickup events")
+	assert.True(t, containsEvent(parsedReplay, rep.EventItemDrop), "no item_drop events")
+}
+
+// Test that dropped items show up in the snapshot of the tick they were dropped at.
+func TestItems_Drop(t *testing.T) {
+	firstDrop := 0
+
+	for _, tick := range parsedReplay.Ticks {
+		if containsEventInTick(tick, rep.EventItemDrop) {
+			firstDrop = tick.Nr
+			break
+		}
+	}
+
+	if firstDrop == 0 {
+		t.Fatal("no item_drop events")
+	}
+
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A snapshot on every tick
+	cfg := csminify.DefaultReplayConfig(parsedReplay.Header.TickRate)
+	cfg.StartTick = firstDrop
+	cfg.EndTick = firstDrop + 5000
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 1, r.Header.SnapshotRate)
+
+	snapshots := make(map[int]rep.Snapshot)
+	for _, s := range r.Snapshots {
+		snapshots[s.Tick] = s
+	}
+
+	drops := 0
+
+	for _, tick := range r.Ticks {
+		for _, e := range tick.Events {
+			if e.Name != rep.EventItemDrop {
+				continue
+			}
+
+			drops++
+
+			id, _ := numAttr(e, rep.AttrKindItemID)
+			x, _ := numAttr(e, "x")
+			y, _ := numAttr(e, "y")
+			z, _ := numAttr(e, "z")
+
+			var item *rep.Item
+
+			for i := range snapshots[tick.Nr].Items {
+				if snapshots[tick.Nr].Items[i].ID == int(id) {
+					item = &snapshots[tick.Nr].Items[i]
+				}
+			}
+
+			if assert.NotNil(t, item, "item %v dropped at tick %d isn't part of the snapshot", id, tick.Nr) {
+				assert.Equal(t, rep.Point{X: int(x), Y: int(y), Z: int(z)}, item.Position)
+			}
+		}
+	}
+
+	assert.NotZero(t, drops)
+}
+
+func TestKillAttributes(t *testing.T) {
+	var headshot bool
+
+	for _, tick := range parsedReplay.Ticks {
+		for _, e := range tick.Events {
+			if e.Name != rep.EventKill {
+				continue
+			}
+
+			for _, attr := range e.Attributes {
+				switch attr.Key {
+				case rep.AttrKindIsHeadshot:
+					headshot = headshot || attr.NumVal == 1
+				case rep.AttrKindDistance:
+					assert.True(t, attr.NumVal >= 0, "negative kill distance")
+				}
+			}
+
+			assert.True(t, hasAttribute(e, rep.AttrKindDistance), "kill without distance")
+		}
+	}
+
+	assert.True(t, headshot, "no headshots")
+}
+
+func TestHurtAttributes(t *testing.T) {
+	var damage float64
+
+	for _, tick := range parsedReplay.Ticks {
+		for _, e := range tick.Events {
+			if e.Name != rep.EventHurt {
+				continue
+			}
+
+			for _, attr := range e.Attributes {
+				if attr.Key == rep.AttrKindHealthDamage {
+					assert.True(t, attr.NumVal >= 0 && attr.NumVal <= 100, "health damage out of range")
+					damage += attr.NumVal
+				}
+			}
+
+			assert.True(t, hasAttribute(e, rep.AttrKindHitGroup), "hurt without hit group")
+		}
+	}
+
+	assert.True(t, damage > 0, "no damage dealt")
+}
+
+func TestPositionSampling(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.PositionSamplingFrequency = 8
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, r.Header.SnapshotRate/16, r.Header.PositionSampleRate)
+
+	ok := false
+	for _, s := range r.Snapshots {
+		for _, u := range s.EntityUpdates {
+			if len(u.Positions) > 1 {
+				ok = true
+			}
+		}
+	}
+	if !ok {
+		t.Fatal("No sampled positions recorded when there should have been some")
+	}
+}
+
+// Test that samples are taken on every multiple of the sample rate, even if it doesn't divide the snapshot rate.
+func TestPositionSampling_UnevenRate(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.PositionSamplingFrequency = 5
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapRate, sampleRate := r.Header.SnapshotRate, r.Header.PositionSampleRate
+	if !assert.NotZero(t, snapRate%sampleRate, "sample rate divides snapshot rate") {
+		return
+	}
+
+	for i := 1; i < len(r.Snapshots); i++ {
+		prev, s := r.Snapshots[i-1], r.Snapshots[i]
+
+		// Sample ticks in (prev.Tick, s.Tick), plus the position at the snapshot's tick
+		expected := (s.Tick-1)/sampleRate - prev.Tick/sampleRate + 1
+
+		for _, u := range s.EntityUpdates {
+			assert.True(t, len(u.Positions) <= expected, "%d positions of entity %d at tick %d, expected at most %d", len(u.Positions), u.EntityID, s.Tick, expected)
+		}
+	}
+}
+
+func TestToReplayWithContext_Cancel(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var progress []csminify.Progress
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.ProgressHandler = func(p csminify.Progress) {
+		progress = append(progress, p)
+
+		if p.Fraction >= 0.5 {
+			cancel()
+		}
+	}
+
+	r, err := csminify.ToReplayWithContext(ctx, f, cfg)
+	assert.Equal(t, context.Canceled, err)
+	assert.NotEmpty(t, r.Snapshots)
+	assert.Less(t, len(r.Snapshots), len(parsedReplay.Snapshots))
+	assert.NotEmpty(t, progress)
+}
+
+func TestToReplayWithContext_AlreadyDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := csminify.ToReplayWithContext(ctx, bytes.NewReader(nil), csminify.DefaultReplayConfig(0.5))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestTickSelection(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.StartTick = 10000
+	cfg.EndTick = 20000
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotEmpty(t, r.Snapshots)
+	for _, s := range r.Snapshots {
+		assert.True(t, s.Tick >= cfg.StartTick && s.Tick <= cfg.EndTick, "snapshot tick %d outside of selection", s.Tick)
+	}
+
+	for _, tick := range r.Ticks {
+		assert.True(t, tick.Nr >= cfg.StartTick && tick.Nr <= cfg.EndTick, "tick %d outside of selection", tick.Nr)
+	}
+}
+
+func TestPlayerFilter(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.PlayerFilter = &csminify.PlayerFilter{
+		Names: []string{parsedReplay.Entities[0].Name},
+	}
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotEmpty(t, r.Entities)
+
+	entityID := r.Entities[0].ID
+	for _, e := range r.Entities {
+		assert.Equal(t, entityID, e.ID)
+	}
+
+	for _, s := range r.Snapshots {
+		for _, u := range s.EntityUpdates {
+			assert.Equal(t, entityID, u.EntityID)
+		}
+	}
+}
+
+func TestEntityRecords(t *testing.T) {
+	joinTicks := make(map[int]int)
+
+	for _, e := range parsedReplay.Entities {
+		assert.True(t, e.JoinTick <= e.Tick, "record of entity %d is older than the join", e.ID)
+
+		if joinTick, ok := joinTicks[e.ID]; ok {
+			assert.Equal(t, joinTick, e.JoinTick, "entity %d joined again before leaving", e.ID)
+		}
+
+		joinTicks[e.ID] = e.JoinTick
+
+		if e.LeaveTick != 0 {
+			assert.Equal(t, e.Tick, e.LeaveTick)
+			delete(joinTicks, e.ID)
+		}
+	}
+}
+
+// Test that selections & filters don't change the join & leave ticks of players.
+func TestEntityRecords_SelectionAndFilter(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.StartTick = 10000
+	cfg.EndTick = 20000
+	cfg.PlayerFilter = &csminify.PlayerFilter{
+		Teams: []common.Team{common.TeamTerrorists},
+	}
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotEmpty(t, r.Entities)
+
+	type stay struct {
+		id, joinTick int
+	}
+
+	leaveTicks := make(map[stay]int)
+	for _, e := range parsedReplay.Entities {
+		leaveTicks[stay{e.ID, e.JoinTick}] = e.LeaveTick
+	}
+
+	filteredLeaveTicks := make(map[stay]int)
+	for _, e := range r.Entities {
+		leaveTick, ok := leaveTicks[stay{e.ID, e.JoinTick}]
+		if assert.True(t, ok, "entity %d has a join tick that's not in the full replay", e.ID) {
+			filteredLeaveTicks[stay{e.ID, e.JoinTick}] = e.LeaveTick
+
+			if e.LeaveTick != 0 {
+				// No leave records because of side switches
+				assert.Equal(t, leaveTick, e.LeaveTick)
+			}
+		}
+	}
+
+	// Players that leave after the selection still get their leave tick
+	for s, leaveTick := range filteredLeaveTicks {
+		assert.Equal(t, leaveTicks[s], leaveTick, "leave tick of entity %d", s.id)
+	}
+}
+
+func TestTeamsAndScores(t *testing.T) {
+	h := parsedReplay.Footer
+
+	assert.Len(t, h.Teams, 2)
+	for _, team := range h.Teams {
+		assert.NotEmpty(t, team.Sides)
+	}
+
+	assert.NotEmpty(t, h.Scores)
+
+	for i := 1; i < len(h.Scores); i++ {
+		assert.True(t, h.Scores[i-1].Tick < h.Scores[i].Tick)
+	}
+
+	last := h.Scores[len(h.Scores)-1]
+	assert.NotZero(t, last.Terrorists+last.CounterTerrorists)
+}
+
+func TestRounds(t *testing.T) {
+	assert.NotEmpty(t, parsedReplay.Rounds)
+
+	for _, r := range parsedReplay.Rounds {
+		assert.NotZero(t, r.Nr)
+		if r.EndTick != 0 {
+			assert.True(t, r.StartTick <= r.EndTick, "round %d", r.Nr)
+		}
+	}
+
+	last := parsedReplay.Rounds[len(parsedReplay.Rounds)-1]
+	assert.NotZero(t, last.ScoreTerrorists+last.ScoreCounterTerrorists)
+}
+
+func TestRoundSelection(t *testing.T) {
+	if !assert.True(t, len(parsedReplay.Rounds) > 2, "not enough rounds") {
+		return
+	}
+
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.Rounds = []int{1, 2}
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Warmup isn't part of the first round, so nothing is recorded before it started
+	first, third := parsedReplay.Rounds[0], parsedReplay.Rounds[2]
+	assert.Equal(t, 1, first.Nr)
+	assert.Equal(t, 3, third.Nr)
+
+	if assert.Len(t, r.Rounds, 2) {
+		assert.Equal(t, parsedReplay.Rounds[:2], r.Rounds)
+	}
+
+	assert.NotEmpty(t, r.Snapshots)
+	for _, s := range r.Snapshots {
+		assert.True(t, s.Tick >= first.StartTick && s.Tick < third.StartTick, "snapshot tick %d outside of rounds 1 & 2", s.Tick)
+	}
+
+	for _, tick := range r.Ticks {
+		assert.True(t, tick.Nr >= first.StartTick && tick.Nr < third.StartTick, "tick %d outside of rounds 1 & 2", tick.Nr)
+	}
+}
+
+func TestSkipWarmup(t *testing.T) {
+	f, err := os.Open(demPath)
+	defer f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := csminify.DefaultReplayConfig(0.5)
+	cfg.SkipWarmup = true
+
+	r, err := csminify.ToReplayWithConfig(f, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Warmup rounds aren't recorded anyway
+	assert.Equal(t, parsedReplay.Rounds, r.Rounds)
+
+	// The warmup ends with the restart before the first round
+	firstRoundStart := parsedReplay.Rounds[0].StartTick
+
+	assert.NotEmpty(t, r.Snapshots)
+	for _, s := range r.Snapshots {
+		assert.True(t, s.Tick >= firstRoundStart, "snapshot tick %d is part of the warmup", s.Tick)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	b, err := ioutil.ReadFile(demPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := parsedReplay.Header
+	checksum := sha256.Sum256(b)
+
+	assert.Equal(t, hex.EncodeToString(checksum[:]), parsedReplay.Footer.SHA256)
+	assert.Equal(t, csminify.Version, h.MinifierVersion)
+	assert.Equal(t, "HL2DEMO", h.Filestamp)
+	assert.NotZero(t, h.PlaybackTime)
+	assert.NotZero(t, h.PlaybackTicks)
+	assert.NotZero(t, h.PlaybackFrames)
+}
+
+func TestEntityAt(t *testing.T) {
+	r := rep.Replay{
+		Entities: []rep.Entity{
+			{ID: 1, Name: "A", Tick: 10, JoinTick: 10},
+			{ID: 1, Name: "A2", Tick: 20, JoinTick: 10},
+			{ID: 1, Name: "A2", Tick: 30, JoinTick: 10, LeaveTick: 30},
+			{ID: 1, Name: "B", Tick: 40, JoinTick: 40},
+		},
+	}
+
+	for tick, expectedName := range map[int]string{5: "", 10: "A", 25: "A2", 35: "", 45: "B"} {
+		e, ok := r.EntityAt(1, tick)
+
+		assert.Equal(t, expectedName != "", ok, "tick %d", tick)
+
+		if ok {
+			assert.Equal(t, expectedName, e.Name, "tick %d", tick)
+		}
+	}
+
+	_, ok := r.EntityAt(2, 15)
+	assert.False(t, ok)
+}
+
+func containsEvent(r rep.Replay, name string) bool {
+	for _, tick := range r.Ticks {
+		if containsEventInTick(tick, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsEventInTick(tick rep.Tick, name string) bool {
+	for _, e := range tick.Events {
+		if e.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAttribute(e rep.Event, key string) bool {
+	_, ok := numAttr(e, key)
+	return ok
+}
+
+func numAttr(e rep.Event, key string) (float64, bool) {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return attr.NumVal, true
+		}
+	}
+
+	return 0, false
+}
+
+func sortedByEntityID(updates []rep.EntityUpdate) []rep.EntityUpdate {
+	res := append([]rep.EntityUpdate(nil), updates...)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].EntityID < res[j].EntityID
+	})
+
+	return res
+}
+
 func TestDemoSet(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test due to -short flag")
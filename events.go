@@ -1,9 +1,16 @@
 package csminify
 
 import (
+	"math"
+	"sort"
+
+	r3 "github.com/golang/geo/r3"
+
 	rep "github.com/markus-wa/cs-demo-minifier/replay"
 	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
 	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
 )
 
 // EventCollector provides the possibility of adding custom events to replays.
@@ -12,9 +19,10 @@ import (
 // The handlers can access game-state information via Parser().
 // After a tick ends all events that were added to the collector during the tick will be stored into the replay.
 type EventCollector struct {
-	handlers []interface{}
-	events   []rep.Event
-	parser   dem.Parser
+	handlers     []interface{}
+	events       []rep.Event
+	parser       dem.Parser
+	playerFilter *PlayerFilter
 }
 
 // AddHandler adds a handler which will be registered on the Parser to the collector.
@@ -29,10 +37,29 @@ func (ec *EventCollector) AddHandler(handler interface{}) {
 
 // AddEvent adds an event to the collector.
 // The event will be added to the replay after the current tick ends.
+// Events that don't involve any player included by ReplayConfig.PlayerFilter are dropped.
 func (ec *EventCollector) AddEvent(event rep.Event) {
+	if ec.playerFilter != nil && !ec.playerFilter.involvesIncludedPlayer(ec.playersByEntityID(), event) {
+		return
+	}
+
 	ec.events = append(ec.events, event)
 }
 
+// playersByEntityID returns the players that events may reference.
+// Players that are disconnecting are included as well, their entity might already be gone when the disconnect event is added.
+func (ec *EventCollector) playersByEntityID() map[int]*common.Player {
+	players := ec.parser.GameState().Participants().ByEntityID()
+
+	for _, pl := range ec.parser.GameState().Participants().All() {
+		if _, ok := players[pl.EntityID]; !ok && pl.IsConnected && pl.EntityID != 0 {
+			players[pl.EntityID] = pl
+		}
+	}
+
+	return players
+}
+
 // Parser returns the demo-parser through which custom handlers can access game-state information.
 // Returns nil before minification has started - so don't call this before you need it.
 func (ec *EventCollector) Parser() dem.Parser {
@@ -50,6 +77,9 @@ type eventHandlers struct {
 	Extra   extraEventHandlers
 }
 
+// unitsToMeters converts distances from game units to meters, 1 unit is 1 inch
+const unitsToMeters = 0.0254
+
 type defaultEventHandlers struct{}
 
 func (defaultEventHandlers) RegisterAll(ec *EventCollector) {
@@ -66,6 +96,11 @@ func (defaultEventHandlers) RegisterAll(ec *EventCollector) {
 	EventHandlers.Default.RegisterWeaponFired(ec)
 	EventHandlers.Default.RegisterChatMessage(ec)
 	EventHandlers.Default.RegisterGrenadeEvents(ec)
+	EventHandlers.Default.RegisterWeaponSwitch(ec)
+	EventHandlers.Default.RegisterBombEvents(ec)
+	EventHandlers.Default.RegisterGrenadeThrow(ec)
+	EventHandlers.Default.RegisterItemEvents(ec)
+	EventHandlers.Default.RegisterHostageEvents(ec)
 }
 
 func (defaultEventHandlers) RegisterMatchStarted(ec *EventCollector) {
@@ -112,13 +147,50 @@ func (defaultEventHandlers) RegisterPlayerKilled(ec *EventCollector) {
 			eb.intAttr(rep.AttrKindAssister, e.Assister.EntityID)
 		}
 
+		eb.boolAttr(rep.AttrKindIsHeadshot, e.IsHeadshot)
+		eb.intAttr(rep.AttrKindPenetratedObjects, e.PenetratedObjects)
+		eb.boolAttr(rep.AttrKindIsNoScope, e.NoScope)
+		eb.boolAttr(rep.AttrKindIsThroughSmoke, e.ThroughSmoke)
+		eb.boolAttr(rep.AttrKindIsAttackerBlind, e.AttackerBlind)
+		eb.boolAttr(rep.AttrKindIsAssistedFlash, e.AssistedFlash)
+
+		// Older demos don't contain the distance, so we calculate it ourselves (in meters, like the game)
+		distance := float64(e.Distance)
+		if distance == 0 && e.Killer != nil && e.Killer != e.Victim {
+			distance = e.Killer.Position().Distance(e.Victim.Position()) * unitsToMeters
+		}
+
+		eb.floatAttr(rep.AttrKindDistance, roundTo(distance, 0.01))
+
 		ec.AddEvent(eb.build())
 	})
 }
 
 func (defaultEventHandlers) RegisterPlayerHurt(ec *EventCollector) {
 	ec.AddHandler(func(e events.PlayerHurt) {
-		ec.AddEvent(createEntityEvent(rep.EventHurt, e.Player.EntityID))
+		if e.Player == nil {
+			return
+		}
+
+		eb := buildEvent(rep.EventHurt)
+		eb.intAttr(rep.AttrKindEntityID, e.Player.EntityID)
+
+		// No attacker for world damage (e.g. falling)
+		if e.Attacker != nil {
+			eb.intAttr(rep.AttrKindAttacker, e.Attacker.EntityID)
+		}
+
+		if e.Weapon != nil {
+			eb.intAttr(rep.AttrKindWeapon, int(e.Weapon.Type))
+		}
+
+		// Without over-damage, so the values can be summed up for ADR etc.
+		eb.intAttr(rep.AttrKindHealthDamage, e.HealthDamageTaken)
+		eb.intAttr(rep.AttrKindArmorDamage, e.ArmorDamageTaken)
+		eb.intAttr(rep.AttrKindHealth, e.Health)
+		eb.intAttr(rep.AttrKindArmor, e.Armor)
+		eb.intAttr(rep.AttrKindHitGroup, int(e.HitGroup))
+		ec.AddEvent(eb.build())
 	})
 }
 
@@ -197,14 +269,48 @@ func (defaultEventHandlers) RegisterGrenadeEvents(ec *EventCollector) {
 		eb.intAttr(rep.AttrKindThrowerID, decoyExpired.Thrower.EntityID)
 		ec.AddEvent(eb.build())
 	})
+
+	// The fire game events usually don't contain the thrower, so we get it from the inferno entity instead
+	infernoThrowers := make(map[int]*common.Player) // By inferno entity-ID
+
+	// The collector might be reused for another demo
+	ec.AddHandler(func(events.DataTablesParsed) {
+		infernoThrowers = make(map[int]*common.Player)
+	})
+
+	ec.AddHandler(func(e events.InfernoStart) {
+		infernoThrowers[e.Inferno.Entity.ID()] = e.Inferno.Thrower()
+	})
+
+	fireThrower := func(e events.GrenadeEvent) *common.Player {
+		if e.Thrower != nil {
+			return e.Thrower
+		}
+
+		if inf, ok := ec.parser.GameState().Infernos()[e.GrenadeEntityID]; ok && inf.Thrower() != nil {
+			return inf.Thrower()
+		}
+
+		return infernoThrowers[e.GrenadeEntityID]
+	}
+
 	ec.AddHandler(func(fireStart events.FireGrenadeStart) {
 		eb := withGrenadePosition(buildEvent(rep.EventFireGrenadeStart), fireStart)
-		// Adding a AttrKindThrowerID throws a Nil Reference
+
+		if thrower := fireThrower(fireStart.GrenadeEvent); thrower != nil {
+			eb.intAttr(rep.AttrKindThrowerID, thrower.EntityID)
+		}
+
 		ec.AddEvent(eb.build())
 	})
 	ec.AddHandler(func(fireExpired events.FireGrenadeExpired) {
 		eb := withGrenadePosition(buildEvent(rep.EventFireGrenadeExpired), fireExpired)
-		// Adding a AttrKindThrowerID throws a Nil Reference
+
+		if thrower := fireThrower(fireExpired.GrenadeEvent); thrower != nil {
+			eb.intAttr(rep.AttrKindThrowerID, thrower.EntityID)
+		}
+
+		delete(infernoThrowers, fireExpired.GrenadeEntityID)
 		ec.AddEvent(eb.build())
 	})
 	ec.AddHandler(func(heEvent events.HeExplode) {
@@ -219,6 +325,308 @@ func (defaultEventHandlers) RegisterGrenadeEvents(ec *EventCollector) {
 	})
 }
 
+func (defaultEventHandlers) RegisterWeaponSwitch(ec *EventCollector) {
+	// There is no reliable game event for this, so we check the active weapons after every frame
+	activeWeapons := make(map[int]int64) // Unique-ID of the active weapon by player entity-ID
+
+	// The collector might be reused for another demo
+	ec.AddHandler(func(events.DataTablesParsed) {
+		activeWeapons = make(map[int]int64)
+	})
+
+	ec.AddHandler(func(events.FrameDone) {
+		for _, pl := range ec.parser.GameState().Participants().Playing() {
+			weapon := pl.ActiveWeapon()
+			if !pl.IsAlive() || weapon == nil {
+				// Don't report the first weapon after spawning
+				delete(activeWeapons, pl.EntityID)
+				continue
+			}
+
+			prev, known := activeWeapons[pl.EntityID]
+			activeWeapons[pl.EntityID] = weapon.UniqueID()
+
+			if known && prev != weapon.UniqueID() {
+				eb := buildEvent(rep.EventWeaponSwitch)
+				eb.intAttr(rep.AttrKindEntityID, pl.EntityID)
+				eb.intAttr(rep.AttrKindWeapon, int(weapon.Type))
+				ec.AddEvent(eb.build())
+			}
+		}
+	})
+}
+
+func (defaultEventHandlers) RegisterBombEvents(ec *EventCollector) {
+	addBombEvent := func(eventName string, player *common.Player, site rune) {
+		eb := withBombPosition(buildEvent(eventName), ec.parser.GameState().Bomb())
+
+		if player != nil {
+			eb.intAttr(rep.AttrKindEntityID, player.EntityID)
+		}
+
+		if site != 0 {
+			eb.stringAttr(rep.AttrKindSite, string(site))
+		}
+
+		ec.AddEvent(eb.build())
+	}
+
+	ec.AddHandler(func(e events.BombPickup) {
+		addBombEvent(rep.EventBombPickup, e.Player, 0)
+	})
+	ec.AddHandler(func(e events.BombDropped) {
+		addBombEvent(rep.EventBombDropped, e.Player, 0)
+	})
+	ec.AddHandler(func(e events.BombPlantBegin) {
+		addBombEvent(rep.EventBombPlantBegin, e.Player, rune(e.Site))
+	})
+	ec.AddHandler(func(e events.BombPlantAborted) {
+		addBombEvent(rep.EventBombPlantAborted, e.Player, 0)
+	})
+	ec.AddHandler(func(e events.BombPlanted) {
+		addBombEvent(rep.EventBombPlanted, e.Player, rune(e.Site))
+	})
+	ec.AddHandler(func(e events.BombDefuseStart) {
+		addBombEvent(rep.EventBombDefuseStart, e.Player, 0)
+	})
+	ec.AddHandler(func(e events.BombDefuseAborted) {
+		addBombEvent(rep.EventBombDefuseAborted, e.Player, 0)
+	})
+	ec.AddHandler(func(e events.BombDefused) {
+		addBombEvent(rep.EventBombDefused, e.Player, rune(e.Site))
+	})
+	ec.AddHandler(func(e events.BombExplode) {
+		addBombEvent(rep.EventBombExploded, e.Player, rune(e.Site))
+	})
+}
+
+func (defaultEventHandlers) RegisterGrenadeThrow(ec *EventCollector) {
+	type thrownGrenade struct {
+		throwerID   int
+		grenadeType common.EquipmentType
+	}
+
+	thrown := make(map[int]thrownGrenade) // By projectile entity-ID
+
+	// The collector might be reused for another demo
+	ec.AddHandler(func(events.DataTablesParsed) {
+		thrown = make(map[int]thrownGrenade)
+	})
+
+	ec.AddHandler(func(e events.GrenadeProjectileThrow) {
+		pl := e.Projectile.Thrower
+		if pl == nil {
+			return
+		}
+
+		grenade := thrownGrenade{throwerID: pl.EntityID}
+		if e.Projectile.WeaponInstance != nil {
+			grenade.grenadeType = e.Projectile.WeaponInstance.Type
+		}
+
+		thrown[e.Projectile.Entity.ID()] = grenade
+
+		pos := pl.Position()
+		vel := pl.Velocity()
+
+		// Pitch is sent as 270 to 90, we use -90 (up) to 90 (down) instead
+		pitch := float64(pl.ViewDirectionY())
+		if pitch > 180 {
+			pitch -= 360
+		}
+
+		eb := buildEvent(rep.EventGrenadeThrown)
+		eb.intAttr(rep.AttrKindEntityID, pl.EntityID)
+		eb.intAttr(rep.AttrKindWeapon, int(grenade.grenadeType))
+		eb.intAttr(rep.AttrKindProjectileID, e.Projectile.Entity.ID())
+		eb.floatAttr("x", pos.X)
+		eb.floatAttr("y", pos.Y)
+		eb.floatAttr("z", pos.Z)
+		eb.floatAttr("yaw", float64(pl.ViewDirectionX()))
+		eb.floatAttr("pitch", pitch)
+		eb.floatAttr("speed", math.Hypot(vel.X, vel.Y))
+		eb.boolAttr("isAirborne", pl.IsAirborne())
+		eb.boolAttr("isDucking", pl.IsDucking())
+		eb.boolAttr("isWalking", pl.IsWalking())
+		ec.AddEvent(eb.build())
+	})
+
+	landed := func(projectileID int, pos r3.Vector) {
+		grenade, ok := thrown[projectileID]
+		if !ok {
+			return
+		}
+
+		delete(thrown, projectileID)
+
+		eb := buildEvent(rep.EventGrenadeLanded)
+		eb.intAttr(rep.AttrKindEntityID, grenade.throwerID)
+		eb.intAttr(rep.AttrKindWeapon, int(grenade.grenadeType))
+		eb.intAttr(rep.AttrKindProjectileID, projectileID)
+		eb.floatAttr("x", pos.X)
+		eb.floatAttr("y", pos.Y)
+		eb.floatAttr("z", pos.Z)
+		ec.AddEvent(eb.build())
+	}
+
+	// Smokes & decoys are only destroyed once they expire
+	ec.AddHandler(func(e events.GrenadeEventIf) {
+		landed(e.Base().GrenadeEntityID, e.Base().Position)
+	})
+
+	ec.AddHandler(func(e events.GrenadeProjectileDestroy) {
+		landed(e.Projectile.Entity.ID(), e.Projectile.Position())
+	})
+}
+
+func (defaultEventHandlers) RegisterItemEvents(ec *EventCollector) {
+	// events.ItemPickup & ItemDrop aren't available in all demos and don't distinguish buying from picking up,
+	// so we compare the owners of the weapons whose owner entity changed after every frame instead
+	var (
+		owners  map[int]int      // Entity-ID of the owner by weapon entity-ID, 0 if on the ground
+		changed map[int]struct{} // Weapons whose owner might have changed during the current frame
+	)
+
+	ec.AddHandler(func(events.DataTablesParsed) {
+		owners = make(map[int]int)
+		changed = make(map[int]struct{})
+
+		for _, sc := range ec.parser.ServerClasses() {
+			if !isWeaponClass(sc) {
+				continue
+			}
+
+			sc.OnEntityCreated(func(e st.Entity) {
+				id := e.ID()
+				changed[id] = struct{}{}
+
+				if prop := e.Property("m_hOwnerEntity"); prop != nil {
+					prop.OnUpdate(func(st.PropertyValue) {
+						changed[id] = struct{}{}
+					})
+				}
+
+				// Entity-IDs are reused, the next weapon with this ID is a different one
+				e.OnDestroy(func() {
+					delete(owners, id)
+					delete(changed, id)
+				})
+			})
+		}
+	})
+
+	ec.AddHandler(func(events.FrameDone) {
+		if len(changed) == 0 {
+			return
+		}
+
+		// Sorted for a deterministic order of the events
+		ids := make([]int, 0, len(changed))
+		for id := range changed {
+			ids = append(ids, id)
+			delete(changed, id)
+		}
+
+		sort.Ints(ids)
+
+		weapons := ec.parser.GameState().Weapons()
+
+		for _, id := range ids {
+			w, ok := weapons[id]
+			if !ok || w.Entity == nil || w.Type == common.EqBomb {
+				// The bomb has its own events
+				continue
+			}
+
+			owner := 0
+			if w.Owner != nil {
+				owner = w.Owner.EntityID
+			}
+
+			prev, known := owners[id]
+			owners[id] = owner
+
+			if !known || prev == owner {
+				continue
+			}
+
+			// Carried weapons don't have a meaningful position, so pickups use the position of the player
+			if prev != 0 {
+				addItemEvent(ec, rep.EventItemDrop, prev, id, w.Type, w.Entity.Position())
+			}
+
+			if owner != 0 {
+				addItemEvent(ec, rep.EventItemPickup, owner, id, w.Type, w.Owner.Position())
+			}
+		}
+	})
+}
+
+// isWeaponClass reports whether entities of the server-class are weapons, see GameState().Weapons().
+func isWeaponClass(sc *st.ServerClass) bool {
+	for _, bc := range sc.BaseClasses() {
+		if bc.Name() == "CWeaponCSBase" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addItemEvent(ec *EventCollector, eventName string, playerID, itemID int, eqType common.EquipmentType, pos r3.Vector) {
+	eb := buildEvent(eventName)
+	eb.intAttr(rep.AttrKindEntityID, playerID)
+	eb.intAttr(rep.AttrKindWeapon, int(eqType))
+	eb.intAttr(rep.AttrKindItemID, itemID)
+	eb.floatAttr("x", pos.X)
+	eb.floatAttr("y", pos.Y)
+	eb.floatAttr("z", pos.Z)
+	ec.AddEvent(eb.build())
+}
+
+func (defaultEventHandlers) RegisterHostageEvents(ec *EventCollector) {
+	// There is no game event for picking up hostages, the carrier is known once the hostage is being carried
+	ec.AddHandler(func(e events.HostageStateChanged) {
+		if e.Hostage == nil || e.NewState != common.HostageStateBeingCarried {
+			return
+		}
+
+		eb := withHostagePosition(buildEvent(rep.EventHostagePickup), e.Hostage)
+
+		if carrier := hostageCarrier(ec.parser, e.Hostage); carrier != nil {
+			eb.intAttr(rep.AttrKindEntityID, carrier.EntityID)
+		}
+
+		ec.AddEvent(eb.build())
+	})
+	ec.AddHandler(func(e events.HostageRecued) {
+		if e.Hostage == nil {
+			return
+		}
+
+		eb := withHostagePosition(buildEvent(rep.EventHostageRescued), e.Hostage)
+
+		if e.Player != nil {
+			eb.intAttr(rep.AttrKindEntityID, e.Player.EntityID)
+		}
+
+		ec.AddEvent(eb.build())
+	})
+	ec.AddHandler(func(e events.HostageKilled) {
+		if e.Hostage == nil {
+			return
+		}
+
+		eb := withHostagePosition(buildEvent(rep.EventHostageKilled), e.Hostage)
+
+		if e.Killer != nil {
+			eb.intAttr(rep.AttrKindKiller, e.Killer.EntityID)
+		}
+
+		ec.AddEvent(eb.build())
+	})
+}
+
 type extraEventHandlers struct{}
 
 func (extraEventHandlers) RegisterAll(ec *EventCollector) {
@@ -258,6 +666,15 @@ func (b *eventBuilder) floatAttr(key string, value float64) {
 	})
 }
 
+func (b *eventBuilder) boolAttr(key string, value bool) {
+	var num float64
+	if value {
+		num = 1
+	}
+
+	b.floatAttr(key, num)
+}
+
 func (b eventBuilder) build() rep.Event {
 	return b.event
 }
@@ -278,6 +695,27 @@ func createEntityEvent(eventName string, entityID int) rep.Event {
 	return buildEvent(eventName).intAttr(rep.AttrKindEntityID, entityID).build()
 }
 
+func withBombPosition(eb *eventBuilder, bomb *common.Bomb) *eventBuilder {
+	pos := bomb.Position()
+
+	eb.floatAttr("x", pos.X)
+	eb.floatAttr("y", pos.Y)
+	eb.floatAttr("z", pos.Z)
+
+	return eb
+}
+
+func withHostagePosition(eb *eventBuilder, hostage *common.Hostage) *eventBuilder {
+	pos := hostage.Position()
+
+	eb.intAttr(rep.AttrKindHostageID, hostage.Entity.ID())
+	eb.floatAttr("x", pos.X)
+	eb.floatAttr("y", pos.Y)
+	eb.floatAttr("z", pos.Z)
+
+	return eb
+}
+
 func withGrenadePosition(eb *eventBuilder, e events.GrenadeEventIf) *eventBuilder {
 	eb.floatAttr("x", e.Base().Position.X)
 	eb.floatAttr("y", e.Base().Position.Y)
@@ -0,0 +1,75 @@
+package csminify
+
+import (
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// PlayerFilter restricts entities, snapshots and events of a replay to a set of players.
+// A player is included if any of the criteria match.
+// Events are included if they involve at least one included player or no player at all (e.g. round_started).
+// The same goes for projectiles & infernos, they are included if they have been thrown by an included player or an unknown one.
+type PlayerFilter struct {
+	SteamIDs []uint64      // 64-bit Steam IDs, see common.Player.SteamID64
+	Names    []string      // In-game names
+	Teams    []common.Team // Current team of the player
+}
+
+// Matches reports whether the player is included by the filter.
+func (f PlayerFilter) Matches(pl *common.Player) bool {
+	if pl == nil {
+		return false
+	}
+
+	for _, id := range f.SteamIDs {
+		if pl.SteamID64 == id {
+			return true
+		}
+	}
+
+	for _, name := range f.Names {
+		if pl.Name == name {
+			return true
+		}
+	}
+
+	for _, team := range f.Teams {
+		if pl.Team == team {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Attributes that reference player entities
+var playerAttributeKinds = map[string]struct{}{
+	rep.AttrKindEntityID:  {},
+	rep.AttrKindVictim:    {},
+	rep.AttrKindKiller:    {},
+	rep.AttrKindAssister:  {},
+	rep.AttrKindSender:    {},
+	rep.AttrKindThrowerID: {},
+	rep.AttrKindAttacker:  {},
+}
+
+// involvesIncludedPlayer reports whether the event references a player included by the filter
+// or doesn't reference any player at all.
+func (f PlayerFilter) involvesIncludedPlayer(players map[int]*common.Player, e rep.Event) bool {
+	referencesPlayer := false
+
+	for _, attr := range e.Attributes {
+		if _, ok := playerAttributeKinds[attr.Key]; !ok {
+			continue
+		}
+
+		referencesPlayer = true
+
+		if f.Matches(players[int(attr.NumVal)]) {
+			return true
+		}
+	}
+
+	return !referencesPlayer
+}
@@ -0,0 +1,39 @@
+package csminify
+
+import (
+	"testing"
+
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	"github.com/stretchr/testify/assert"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+func TestAddEvent_PlayerFilter(t *testing.T) {
+	included := &common.Player{EntityID: 1, Name: "included", IsConnected: true}
+	excluded := &common.Player{EntityID: 2, Name: "excluded", IsConnected: true}
+	disconnecting := &common.Player{EntityID: 3, Name: "included", IsConnected: true} // Entity already gone
+	disconnected := &common.Player{EntityID: 4, Name: "included"}
+
+	ec := &EventCollector{
+		parser: &parserStub{gameState: &gameStateStub{
+			playing: []*common.Player{included, excluded},
+			all:     []*common.Player{included, excluded, disconnecting, disconnected},
+		}},
+		playerFilter: &PlayerFilter{Names: []string{"included"}},
+	}
+
+	for _, id := range []int{1, 2, 3, 4} {
+		ec.AddEvent(createEntityEvent(rep.EventDisconnect, id))
+	}
+
+	ec.AddEvent(createEvent(rep.EventRoundStarted))
+
+	expected := []rep.Event{
+		createEntityEvent(rep.EventDisconnect, 1),
+		createEntityEvent(rep.EventDisconnect, 3),
+		createEvent(rep.EventRoundStarted),
+	}
+
+	assert.Equal(t, expected, ec.events)
+}
@@ -0,0 +1,61 @@
+package csminify
+
+import (
+	"sort"
+
+	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// Entity handles contain the entity-ID in the lower 11 bits
+const entityHandleIndexMask = (1 << 11) - 1
+
+// hostages returns the state of all hostages, sorted by entity-ID.
+func (m *minifier) hostages() []rep.Hostage {
+	var result []rep.Hostage
+
+	for _, h := range m.parser.GameState().Hostages() {
+		if h.Entity == nil {
+			continue
+		}
+
+		hostage := rep.Hostage{
+			ID:       h.Entity.ID(),
+			Position: r3VectorToPoint(h.Position()),
+			State:    int(h.State()),
+			Hp:       h.Health(),
+		}
+
+		if carrier := hostageCarrier(m.parser, h); carrier != nil {
+			hostage.CarrierID = carrier.EntityID
+		}
+
+		result = append(result, hostage)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// hostageCarrier returns the player carrying the hostage, or the player it is following on older maps.
+// Returns nil if the hostage is neither carried nor following anyone.
+func hostageCarrier(p dem.Parser, h *common.Hostage) *common.Player {
+	id := h.Entity.ID()
+
+	for _, pl := range p.GameState().Participants().Playing() {
+		if pl.Entity == nil {
+			continue
+		}
+
+		if val, ok := pl.Entity.PropertyValue("m_hCarriedHostage"); ok && val.IntVal&entityHandleIndexMask == id {
+			return pl
+		}
+	}
+
+	return h.Leader()
+}
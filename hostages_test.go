@@ -0,0 +1,144 @@
+package csminify
+
+import (
+	"testing"
+
+	r3 "github.com/golang/geo/r3"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+	"github.com/stretchr/testify/assert"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// No hostage map is part of the test demos, so the hostage tracking is tested against stubs, see stubs_test.go.
+
+// entityHandle returns an entity handle with a serial number in the upper bits.
+func entityHandle(entityID int) int {
+	return 7<<11 | entityID
+}
+
+func newHostageStub(provider demoInfoProviderStub, id int, state common.HostageState, props map[string]int) *common.Hostage {
+	allProps := map[string]int{
+		"m_nHostageState": int(state),
+		"m_iHealth":       100,
+	}
+
+	for k, v := range props {
+		allProps[k] = v
+	}
+
+	return common.NewHostage(provider, &entityStub{
+		id:       id,
+		position: r3.Vector{X: float64(id), Y: 2, Z: 3},
+		props:    allProps,
+	})
+}
+
+func newPlayerStub(entityID int, carriedHostageHandle int) *common.Player {
+	return &common.Player{
+		EntityID: entityID,
+		Entity: &entityStub{
+			id:    entityID,
+			props: map[string]int{"m_hCarriedHostage": carriedHostageHandle},
+		},
+	}
+}
+
+func TestHostageCarrier(t *testing.T) {
+	carrier := newPlayerStub(1, entityHandle(100))
+	other := newPlayerStub(2, entityHandle(101))
+	leader := newPlayerStub(3, 0)
+
+	provider := demoInfoProviderStub{
+		playersByHandle: map[int]*common.Player{entityHandle(leader.EntityID): leader},
+	}
+
+	parser := &parserStub{gameState: &gameStateStub{playing: []*common.Player{other, carrier, leader}}}
+
+	// The handle's serial number in the upper bits must be ignored
+	carried := newHostageStub(provider, 100, common.HostageStateBeingCarried, nil)
+	assert.Equal(t, carrier, hostageCarrier(parser, carried))
+
+	// Hostages on older maps follow the player instead of being carried
+	following := newHostageStub(provider, 102, common.HostageStateFollowingPlayer, map[string]int{"m_leader": entityHandle(leader.EntityID)})
+	assert.Equal(t, leader, hostageCarrier(parser, following))
+
+	idle := newHostageStub(provider, 103, common.HostageStateIdle, nil)
+	assert.Nil(t, hostageCarrier(parser, idle))
+}
+
+func TestHostages(t *testing.T) {
+	carrier := newPlayerStub(1, entityHandle(100))
+	provider := demoInfoProviderStub{}
+
+	parser := &parserStub{gameState: &gameStateStub{
+		playing: []*common.Player{carrier},
+		hostages: []*common.Hostage{
+			newHostageStub(provider, 101, common.HostageStateDead, map[string]int{"m_iHealth": 0}),
+			newHostageStub(provider, 100, common.HostageStateBeingCarried, nil),
+			common.NewHostage(provider, nil), // Not (or no longer) backed by an entity
+		},
+	}}
+
+	m := &minifier{parser: parser}
+
+	expected := []rep.Hostage{
+		{ID: 100, Position: rep.Point{X: 100, Y: 2, Z: 3}, State: int(common.HostageStateBeingCarried), CarrierID: 1, Hp: 100},
+		{ID: 101, Position: rep.Point{X: 101, Y: 2, Z: 3}, State: int(common.HostageStateDead), Hp: 0},
+	}
+
+	assert.Equal(t, expected, m.hostages())
+}
+
+func TestHostageEvents(t *testing.T) {
+	carrier := newPlayerStub(1, entityHandle(100))
+	rescuer := newPlayerStub(2, 0)
+	killer := newPlayerStub(3, 0)
+	provider := demoInfoProviderStub{}
+
+	hostage := newHostageStub(provider, 100, common.HostageStateBeingCarried, nil)
+
+	ec := &EventCollector{parser: &parserStub{gameState: &gameStateStub{playing: []*common.Player{carrier}}}}
+	EventHandlers.Default.RegisterHostageEvents(ec)
+
+	dispatch := func(e interface{}) {
+		for _, h := range ec.handlers {
+			switch handler := h.(type) {
+			case func(events.HostageStateChanged):
+				if e, ok := e.(events.HostageStateChanged); ok {
+					handler(e)
+				}
+			case func(events.HostageRecued):
+				if e, ok := e.(events.HostageRecued); ok {
+					handler(e)
+				}
+			case func(events.HostageKilled):
+				if e, ok := e.(events.HostageKilled); ok {
+					handler(e)
+				}
+			}
+		}
+	}
+
+	// Only picking up the hostage is an event, other state changes aren't
+	dispatch(events.HostageStateChanged{OldState: common.HostageStateIdle, NewState: common.HostageStateBeingUntied, Hostage: hostage})
+	dispatch(events.HostageStateChanged{OldState: common.HostageStateGettingPickedUp, NewState: common.HostageStateBeingCarried, Hostage: hostage})
+	dispatch(events.HostageRecued{Player: rescuer, Hostage: hostage})
+	dispatch(events.HostageKilled{Killer: killer, Hostage: hostage})
+
+	position := []rep.EventAttribute{
+		{Key: rep.AttrKindHostageID, NumVal: 100},
+		{Key: "x", NumVal: 100},
+		{Key: "y", NumVal: 2},
+		{Key: "z", NumVal: 3},
+	}
+
+	expected := []rep.Event{
+		{Name: rep.EventHostagePickup, Attributes: append(position[:len(position):len(position)], rep.EventAttribute{Key: rep.AttrKindEntityID, NumVal: 1})},
+		{Name: rep.EventHostageRescued, Attributes: append(position[:len(position):len(position)], rep.EventAttribute{Key: rep.AttrKindEntityID, NumVal: 2})},
+		{Name: rep.EventHostageKilled, Attributes: append(position[:len(position):len(position)], rep.EventAttribute{Key: rep.AttrKindKiller, NumVal: 3})},
+	}
+
+	assert.Equal(t, expected, ec.events)
+}
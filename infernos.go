@@ -0,0 +1,71 @@
+package csminify
+
+import (
+	"fmt"
+	"sort"
+
+	r3 "github.com/golang/geo/r3"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// infernos returns the burning area of all molotovs & incendiaries, sorted by entity-ID.
+func (m *minifier) infernos() []rep.Inferno {
+	var result []rep.Inferno
+
+	for id, inf := range m.parser.GameState().Infernos() {
+		thrower := inf.Thrower()
+		if !m.includesOwnedBy(thrower) {
+			continue
+		}
+
+		fires := burningFires(inf.Entity)
+		if len(fires) == 0 {
+			continue
+		}
+
+		record := rep.Inferno{
+			ID:    id,
+			Fires: fires,
+		}
+
+		if thrower != nil {
+			record.ThrowerID = thrower.EntityID
+		}
+
+		result = append(result, record)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// burningFires returns the positions of the burning fire cells of an inferno entity.
+// common.Fires doesn't give access to the individual cells, so we read them ourselves.
+func burningFires(entity st.Entity) []rep.Point {
+	origin := entity.Position()
+	n := entity.PropertyValueMust("m_fireCount").IntVal
+
+	var fires []rep.Point
+
+	for i := 0; i < n; i++ {
+		suffix := fmt.Sprintf(".%03d", i)
+		if entity.PropertyValueMust("m_bFireIsBurning"+suffix).IntVal != 1 {
+			continue
+		}
+
+		offset := r3.Vector{
+			X: float64(entity.PropertyValueMust("m_fireXDelta" + suffix).IntVal),
+			Y: float64(entity.PropertyValueMust("m_fireYDelta" + suffix).IntVal),
+			Z: float64(entity.PropertyValueMust("m_fireZDelta" + suffix).IntVal),
+		}
+
+		fires = append(fires, r3VectorToPoint(origin.Add(offset)))
+	}
+
+	return fires
+}
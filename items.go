@@ -0,0 +1,76 @@
+package csminify
+
+import (
+	"sort"
+
+	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// itemTracker keeps track of items lying around in the world.
+// Weapons are available through GameState().Weapons(), but dropped defuse kits aren't.
+type itemTracker struct {
+	parser     dem.Parser
+	defuseKits map[int]st.Entity // By entity-ID
+}
+
+func newItemTracker(p dem.Parser) *itemTracker {
+	it := &itemTracker{
+		parser:     p,
+		defuseKits: make(map[int]st.Entity),
+	}
+
+	p.RegisterEventHandler(func(events.DataTablesParsed) {
+		sc := p.ServerClasses().FindByName("CItemDefuser")
+		if sc == nil {
+			return
+		}
+
+		sc.OnEntityCreated(func(e st.Entity) {
+			id := e.ID()
+			it.defuseKits[id] = e
+
+			e.OnDestroy(func() {
+				delete(it.defuseKits, id)
+			})
+		})
+	})
+
+	return it
+}
+
+// state returns all items that aren't carried by a player, sorted by entity-ID.
+// The bomb isn't included, see Snapshot.Bomb.
+func (it *itemTracker) state() []rep.Item {
+	var items []rep.Item
+
+	for id, w := range it.parser.GameState().Weapons() {
+		if w.Owner != nil || w.Entity == nil || w.Type == common.EqBomb {
+			continue
+		}
+
+		items = append(items, rep.Item{
+			ID:       id,
+			Type:     int(w.Type),
+			Position: r3VectorToPoint(w.Entity.Position()),
+		})
+	}
+
+	for id, kit := range it.defuseKits {
+		items = append(items, rep.Item{
+			ID:       id,
+			Type:     int(common.EqDefuseKit),
+			Position: r3VectorToPoint(kit.Position()),
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+
+	return items
+}
@@ -7,12 +7,14 @@ import (
 	"os"
 	"testing"
 
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
 	"github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/vmihailenco/msgpack.v2"
 
 	min "github.com/markus-wa/cs-demo-minifier"
 	"github.com/markus-wa/cs-demo-minifier/protobuf"
+	"github.com/markus-wa/cs-demo-minifier/protobuf/gen"
 	rep "github.com/markus-wa/cs-demo-minifier/replay"
 )
 
@@ -62,6 +64,39 @@ func TestProtobufDemo(t *testing.T) {
 	testDataPreservation(parsedReplay, protobuf.MarshalReplay, protobuf.UnmarshalReplay, t)
 }
 
+// Test that the Protobuf hostage states match demoinfocs' and survive marshalling & unmarshalling.
+func TestProtobufHostageStates(t *testing.T) {
+	states := map[common.HostageState]gen.Replay_Snapshot_Hostage_State{
+		common.HostageStateIdle:            gen.Replay_Snapshot_Hostage_IDLE,
+		common.HostageStateBeingUntied:     gen.Replay_Snapshot_Hostage_BEING_UNTIED,
+		common.HostageStateGettingPickedUp: gen.Replay_Snapshot_Hostage_GETTING_PICKED_UP,
+		common.HostageStateBeingCarried:    gen.Replay_Snapshot_Hostage_BEING_CARRIED,
+		common.HostageStateFollowingPlayer: gen.Replay_Snapshot_Hostage_FOLLOWING_PLAYER,
+		common.HostageStateGettingDropped:  gen.Replay_Snapshot_Hostage_GETTING_DROPPED,
+		common.HostageStateRescued:         gen.Replay_Snapshot_Hostage_RESCUED,
+		common.HostageStateDead:            gen.Replay_Snapshot_Hostage_DEAD,
+	}
+
+	for state, pbState := range states {
+		assert.Equal(t, int32(state), int32(pbState), "protobuf value of %s", pbState)
+	}
+
+	snap := rep.Snapshot{Tick: 1}
+
+	for state := common.HostageStateIdle; state <= common.HostageStateDead; state++ {
+		snap.Hostages = append(snap.Hostages, rep.Hostage{
+			ID:       100 + int(state),
+			Position: rep.Point{X: 1, Y: 2, Z: 3},
+			State:    int(state),
+			Hp:       100,
+		})
+	}
+
+	assert.Len(t, states, len(snap.Hostages))
+
+	testDataPreservation(rep.Replay{Snapshots: []rep.Snapshot{snap}}, protobuf.MarshalReplay, protobuf.UnmarshalReplay, t)
+}
+
 // Test data preservation of Protobuf marshalling & unmarshalling with a custom events & attributes.
 func TestProtobufCustomEvents(t *testing.T) {
 	f, err := os.Open(demPath)
@@ -88,6 +123,140 @@ func TestProtobufCustomEvents(t *testing.T) {
 	testDataPreservation(customReplay, protobuf.MarshalReplay, protobuf.UnmarshalReplay, t)
 }
 
+// Test data preservation of JSON stream writing & reading with a 'non-default' replay.
+func TestJSONStreamNonDefault(t *testing.T) {
+	testDataPreservation(nonDefaultReplay, streamMarshaller(min.NewJSONStreamWriter), min.UnmarshalJSONStream, t)
+}
+
+// Test data preservation of MessagePack stream writing & reading with a 'non-default' replay.
+func TestMsgPackStreamNonDefault(t *testing.T) {
+	testDataPreservation(nonDefaultReplay, streamMarshaller(min.NewMsgPackStreamWriter), min.UnmarshalMsgPackStream, t)
+}
+
+// Test data preservation of Protobuf stream writing & regular unmarshalling with a 'non-default' replay.
+func TestProtobufStreamNonDefault(t *testing.T) {
+	newStreamWriter := func(w io.Writer) min.ReplaySink {
+		return protobuf.NewStreamWriter(w)
+	}
+
+	testDataPreservation(nonDefaultReplay, streamMarshaller(newStreamWriter), protobuf.UnmarshalReplay, t)
+}
+
+// Test streaming a demo directly into a sink.
+func TestMinifyToSink(t *testing.T) {
+	f, err := os.Open(demPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	buf := new(bytes.Buffer)
+	err = min.MinifyToSink(f, min.DefaultReplayConfig(0.5), protobuf.NewStreamWriter(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r rep.Replay
+	err = protobuf.UnmarshalReplay(buf, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, parsedReplay.Header, r.Header)
+	assert.Equal(t, parsedReplay.Footer, r.Footer)
+	assert.Len(t, r.Snapshots, len(parsedReplay.Snapshots))
+	assert.Len(t, r.Ticks, len(parsedReplay.Ticks))
+}
+
+// Test that streamed records start with the header and end with the footer.
+func TestMinifyToSink_RecordOrder(t *testing.T) {
+	f, err := os.Open(demPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	buf := new(bytes.Buffer)
+	err = min.MinifyToSink(f, min.DefaultReplayConfig(0.5), min.NewJSONStreamWriter(buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records []rep.StreamRecord
+
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var rec rep.StreamRecord
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatal(err)
+		}
+
+		records = append(records, rec)
+	}
+
+	if assert.True(t, len(records) > 2) {
+		first, last := records[0], records[len(records)-1]
+
+		if assert.NotNil(t, first.Header) {
+			assert.Equal(t, parsedReplay.Header, *first.Header)
+			assert.NotZero(t, first.Header.TickRate)
+		}
+
+		if assert.NotNil(t, last.Footer) {
+			assert.Equal(t, parsedReplay.Footer, *last.Footer)
+		}
+	}
+}
+
+// streamMarshaller returns a ReplayMarshaller that writes a replay through a ReplaySink.
+func streamMarshaller(newSink func(io.Writer) min.ReplaySink) min.ReplayMarshaller {
+	return func(r rep.Replay, w io.Writer) error {
+		sink := newSink(w)
+
+		if err := sink.Header(r.Header); err != nil {
+			return err
+		}
+
+		for _, e := range r.Entities {
+			if err := sink.Entity(e); err != nil {
+				return err
+			}
+		}
+
+		for _, s := range r.Snapshots {
+			if err := sink.Snapshot(s); err != nil {
+				return err
+			}
+		}
+
+		for _, t := range r.Ticks {
+			if err := sink.Tick(t); err != nil {
+				return err
+			}
+		}
+
+		for _, w := range r.Warnings {
+			if err := sink.Warning(w); err != nil {
+				return err
+			}
+		}
+
+		for _, round := range r.Rounds {
+			if err := sink.Round(round); err != nil {
+				return err
+			}
+		}
+
+		for _, p := range r.Projectiles {
+			if err := sink.Projectile(p); err != nil {
+				return err
+			}
+		}
+
+		return sink.Footer(r.Footer)
+	}
+}
+
 type replayUnmarshaller func(io.Reader, *rep.Replay) error
 
 func testDataPreservation(replay rep.Replay, marshal min.ReplayMarshaller, unmarshal replayUnmarshaller, t *testing.T) {
@@ -0,0 +1,100 @@
+package csminify
+
+import (
+	"sort"
+
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/events"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// flyingProjectile is a grenade projectile that hasn't detonated yet.
+type flyingProjectile struct {
+	projectile *common.GrenadeProjectile
+	record     rep.Projectile
+}
+
+func (m *minifier) registerProjectileHandlers() {
+	m.parser.RegisterEventHandler(func(e events.GrenadeProjectileThrow) {
+		m.throwProjectile(e.Projectile)
+	})
+
+	// Smokes & decoys stay around after the detonation, so we don't wait for them to be destroyed
+	m.parser.RegisterEventHandler(func(e events.GrenadeEventIf) {
+		m.detonateProjectile(e.Base().GrenadeEntityID)
+	})
+
+	m.parser.RegisterEventHandler(func(e events.GrenadeProjectileDestroy) {
+		m.detonateProjectile(e.Projectile.Entity.ID())
+	})
+}
+
+func (m *minifier) throwProjectile(p *common.GrenadeProjectile) {
+	// Only record projectiles thrown inside the selection, but follow them outside of it
+	if !m.isSelected(m.parser.CurrentFrame()) || !m.includesOwnedBy(p.Thrower) {
+		return
+	}
+
+	record := rep.Projectile{
+		ID:         p.Entity.ID(),
+		ThrowTick:  m.parser.CurrentFrame(),
+		Trajectory: []rep.Point{r3VectorToPoint(p.Position())},
+	}
+
+	if p.Thrower != nil {
+		record.ThrowerID = p.Thrower.EntityID
+	}
+
+	if p.WeaponInstance != nil {
+		record.Type = int(p.WeaponInstance.Type)
+	}
+
+	m.projectiles[record.ID] = &flyingProjectile{
+		projectile: p,
+		record:     record,
+	}
+}
+
+// sampleProjectiles adds the current position of all flying projectiles to their trajectories.
+func (m *minifier) sampleProjectiles(tick int) {
+	for _, fp := range m.projectiles {
+		if (tick-fp.record.ThrowTick)%m.header.ProjectileSampleRate == 0 && tick != fp.record.ThrowTick {
+			fp.record.Trajectory = append(fp.record.Trajectory, r3VectorToPoint(fp.projectile.Position()))
+		}
+	}
+}
+
+func (m *minifier) detonateProjectile(entityID int) {
+	fp, ok := m.projectiles[entityID]
+	if !ok {
+		return
+	}
+
+	delete(m.projectiles, entityID)
+
+	fp.record.DetonationTick = m.parser.CurrentFrame()
+	fp.record.Trajectory = append(fp.record.Trajectory, r3VectorToPoint(fp.projectile.Position()))
+
+	m.abort(m.sink.Projectile(fp.record))
+}
+
+// flushProjectiles writes projectiles that were still flying when the demo ended.
+func (m *minifier) flushProjectiles() {
+	if m.err != nil {
+		return
+	}
+
+	ids := make([]int, 0, len(m.projectiles))
+	for id := range m.projectiles {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		m.abort(m.sink.Projectile(m.projectiles[id].record))
+	}
+
+	m.projectiles = make(map[int]*flyingProjectile)
+}
@@ -54,6 +54,49 @@ func (Team) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_eed9461330ccfc03, []int{0}
 }
 
+type Replay_Snapshot_Hostage_State int32
+
+const (
+	Replay_Snapshot_Hostage_IDLE              Replay_Snapshot_Hostage_State = 0
+	Replay_Snapshot_Hostage_BEING_UNTIED      Replay_Snapshot_Hostage_State = 1
+	Replay_Snapshot_Hostage_GETTING_PICKED_UP Replay_Snapshot_Hostage_State = 2
+	Replay_Snapshot_Hostage_BEING_CARRIED     Replay_Snapshot_Hostage_State = 3
+	Replay_Snapshot_Hostage_FOLLOWING_PLAYER  Replay_Snapshot_Hostage_State = 4
+	Replay_Snapshot_Hostage_GETTING_DROPPED   Replay_Snapshot_Hostage_State = 5
+	Replay_Snapshot_Hostage_RESCUED           Replay_Snapshot_Hostage_State = 6
+	Replay_Snapshot_Hostage_DEAD              Replay_Snapshot_Hostage_State = 7
+)
+
+var Replay_Snapshot_Hostage_State_name = map[int32]string{
+	0: "IDLE",
+	1: "BEING_UNTIED",
+	2: "GETTING_PICKED_UP",
+	3: "BEING_CARRIED",
+	4: "FOLLOWING_PLAYER",
+	5: "GETTING_DROPPED",
+	6: "RESCUED",
+	7: "DEAD",
+}
+
+var Replay_Snapshot_Hostage_State_value = map[string]int32{
+	"IDLE":              0,
+	"BEING_UNTIED":      1,
+	"GETTING_PICKED_UP": 2,
+	"BEING_CARRIED":     3,
+	"FOLLOWING_PLAYER":  4,
+	"GETTING_DROPPED":   5,
+	"RESCUED":           6,
+	"DEAD":              7,
+}
+
+func (x Replay_Snapshot_Hostage_State) String() string {
+	return proto.EnumName(Replay_Snapshot_Hostage_State_name, int32(x))
+}
+
+func (Replay_Snapshot_Hostage_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 7, 0}
+}
+
 type Replay_Tick_Event_Kind int32
 
 const (
@@ -77,6 +120,23 @@ const (
 	Replay_Tick_Event_FIRE_GRENADE_EXPIRED Replay_Tick_Event_Kind = 17
 	Replay_Tick_Event_HE_GRENADE_EXPLOSION Replay_Tick_Event_Kind = 18
 	Replay_Tick_Event_FLASH_EXPLOSION      Replay_Tick_Event_Kind = 19
+	Replay_Tick_Event_WEAPON_SWITCH        Replay_Tick_Event_Kind = 20
+	Replay_Tick_Event_BOMB_PICKUP          Replay_Tick_Event_Kind = 21
+	Replay_Tick_Event_BOMB_DROPPED         Replay_Tick_Event_Kind = 22
+	Replay_Tick_Event_BOMB_PLANT_BEGIN     Replay_Tick_Event_Kind = 23
+	Replay_Tick_Event_BOMB_PLANT_ABORTED   Replay_Tick_Event_Kind = 24
+	Replay_Tick_Event_BOMB_PLANTED         Replay_Tick_Event_Kind = 25
+	Replay_Tick_Event_BOMB_DEFUSE_START    Replay_Tick_Event_Kind = 26
+	Replay_Tick_Event_BOMB_DEFUSE_ABORTED  Replay_Tick_Event_Kind = 27
+	Replay_Tick_Event_BOMB_DEFUSED         Replay_Tick_Event_Kind = 28
+	Replay_Tick_Event_BOMB_EXPLODED        Replay_Tick_Event_Kind = 29
+	Replay_Tick_Event_GRENADE_THROWN       Replay_Tick_Event_Kind = 30
+	Replay_Tick_Event_GRENADE_LANDED       Replay_Tick_Event_Kind = 31
+	Replay_Tick_Event_ITEM_PICKUP          Replay_Tick_Event_Kind = 32
+	Replay_Tick_Event_ITEM_DROP            Replay_Tick_Event_Kind = 33
+	Replay_Tick_Event_HOSTAGE_PICKUP       Replay_Tick_Event_Kind = 34
+	Replay_Tick_Event_HOSTAGE_RESCUED      Replay_Tick_Event_Kind = 35
+	Replay_Tick_Event_HOSTAGE_KILLED       Replay_Tick_Event_Kind = 36
 )
 
 var Replay_Tick_Event_Kind_name = map[int32]string{
@@ -100,6 +160,23 @@ var Replay_Tick_Event_Kind_name = map[int32]string{
 	17: "FIRE_GRENADE_EXPIRED",
 	18: "HE_GRENADE_EXPLOSION",
 	19: "FLASH_EXPLOSION",
+	20: "WEAPON_SWITCH",
+	21: "BOMB_PICKUP",
+	22: "BOMB_DROPPED",
+	23: "BOMB_PLANT_BEGIN",
+	24: "BOMB_PLANT_ABORTED",
+	25: "BOMB_PLANTED",
+	26: "BOMB_DEFUSE_START",
+	27: "BOMB_DEFUSE_ABORTED",
+	28: "BOMB_DEFUSED",
+	29: "BOMB_EXPLODED",
+	30: "GRENADE_THROWN",
+	31: "GRENADE_LANDED",
+	32: "ITEM_PICKUP",
+	33: "ITEM_DROP",
+	34: "HOSTAGE_PICKUP",
+	35: "HOSTAGE_RESCUED",
+	36: "HOSTAGE_KILLED",
 }
 
 var Replay_Tick_Event_Kind_value = map[string]int32{
@@ -123,6 +200,23 @@ var Replay_Tick_Event_Kind_value = map[string]int32{
 	"FIRE_GRENADE_EXPIRED": 17,
 	"HE_GRENADE_EXPLOSION": 18,
 	"FLASH_EXPLOSION":      19,
+	"WEAPON_SWITCH":        20,
+	"BOMB_PICKUP":          21,
+	"BOMB_DROPPED":         22,
+	"BOMB_PLANT_BEGIN":     23,
+	"BOMB_PLANT_ABORTED":   24,
+	"BOMB_PLANTED":         25,
+	"BOMB_DEFUSE_START":    26,
+	"BOMB_DEFUSE_ABORTED":  27,
+	"BOMB_DEFUSED":         28,
+	"BOMB_EXPLODED":        29,
+	"GRENADE_THROWN":       30,
+	"GRENADE_LANDED":       31,
+	"ITEM_PICKUP":          32,
+	"ITEM_DROP":            33,
+	"HOSTAGE_PICKUP":       34,
+	"HOSTAGE_RESCUED":      35,
+	"HOSTAGE_KILLED":       36,
 }
 
 func (x Replay_Tick_Event_Kind) String() string {
@@ -130,42 +224,96 @@ func (x Replay_Tick_Event_Kind) String() string {
 }
 
 func (Replay_Tick_Event_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 0, 0}
+	return fileDescriptor_eed9461330ccfc03, []int{1, 4, 0, 0}
 }
 
 type Replay_Tick_Event_Attribute_Kind int32
 
 const (
-	Replay_Tick_Event_Attribute_ENTITY_ID         Replay_Tick_Event_Attribute_Kind = 0
-	Replay_Tick_Event_Attribute_VICTIM            Replay_Tick_Event_Attribute_Kind = 1
-	Replay_Tick_Event_Attribute_KILLER            Replay_Tick_Event_Attribute_Kind = 2
-	Replay_Tick_Event_Attribute_ASSISTER          Replay_Tick_Event_Attribute_Kind = 3
-	Replay_Tick_Event_Attribute_TEXT              Replay_Tick_Event_Attribute_Kind = 4
-	Replay_Tick_Event_Attribute_EVENT_NAME        Replay_Tick_Event_Attribute_Kind = 5
-	Replay_Tick_Event_Attribute_CUSTOM            Replay_Tick_Event_Attribute_Kind = 6
-	Replay_Tick_Event_Attribute_THROWER_ENTITY_ID Replay_Tick_Event_Attribute_Kind = 7
+	Replay_Tick_Event_Attribute_ENTITY_ID          Replay_Tick_Event_Attribute_Kind = 0
+	Replay_Tick_Event_Attribute_VICTIM             Replay_Tick_Event_Attribute_Kind = 1
+	Replay_Tick_Event_Attribute_KILLER             Replay_Tick_Event_Attribute_Kind = 2
+	Replay_Tick_Event_Attribute_ASSISTER           Replay_Tick_Event_Attribute_Kind = 3
+	Replay_Tick_Event_Attribute_TEXT               Replay_Tick_Event_Attribute_Kind = 4
+	Replay_Tick_Event_Attribute_EVENT_NAME         Replay_Tick_Event_Attribute_Kind = 5
+	Replay_Tick_Event_Attribute_CUSTOM             Replay_Tick_Event_Attribute_Kind = 6
+	Replay_Tick_Event_Attribute_THROWER_ENTITY_ID  Replay_Tick_Event_Attribute_Kind = 7
+	Replay_Tick_Event_Attribute_SITE               Replay_Tick_Event_Attribute_Kind = 8
+	Replay_Tick_Event_Attribute_PROJECTILE_ID      Replay_Tick_Event_Attribute_Kind = 9
+	Replay_Tick_Event_Attribute_ITEM_ID            Replay_Tick_Event_Attribute_Kind = 10
+	Replay_Tick_Event_Attribute_HOSTAGE_ID         Replay_Tick_Event_Attribute_Kind = 11
+	Replay_Tick_Event_Attribute_WEAPON             Replay_Tick_Event_Attribute_Kind = 12
+	Replay_Tick_Event_Attribute_IS_HEADSHOT        Replay_Tick_Event_Attribute_Kind = 13
+	Replay_Tick_Event_Attribute_PENETRATED_OBJECTS Replay_Tick_Event_Attribute_Kind = 14
+	Replay_Tick_Event_Attribute_IS_NO_SCOPE        Replay_Tick_Event_Attribute_Kind = 15
+	Replay_Tick_Event_Attribute_IS_THROUGH_SMOKE   Replay_Tick_Event_Attribute_Kind = 16
+	Replay_Tick_Event_Attribute_IS_ATTACKER_BLIND  Replay_Tick_Event_Attribute_Kind = 17
+	Replay_Tick_Event_Attribute_IS_ASSISTED_FLASH  Replay_Tick_Event_Attribute_Kind = 18
+	Replay_Tick_Event_Attribute_DISTANCE           Replay_Tick_Event_Attribute_Kind = 19
+	Replay_Tick_Event_Attribute_ATTACKER           Replay_Tick_Event_Attribute_Kind = 20
+	Replay_Tick_Event_Attribute_HEALTH_DAMAGE      Replay_Tick_Event_Attribute_Kind = 21
+	Replay_Tick_Event_Attribute_ARMOR_DAMAGE       Replay_Tick_Event_Attribute_Kind = 22
+	Replay_Tick_Event_Attribute_HEALTH             Replay_Tick_Event_Attribute_Kind = 23
+	Replay_Tick_Event_Attribute_ARMOR              Replay_Tick_Event_Attribute_Kind = 24
+	Replay_Tick_Event_Attribute_HIT_GROUP          Replay_Tick_Event_Attribute_Kind = 25
 )
 
 var Replay_Tick_Event_Attribute_Kind_name = map[int32]string{
-	0: "ENTITY_ID",
-	1: "VICTIM",
-	2: "KILLER",
-	3: "ASSISTER",
-	4: "TEXT",
-	5: "EVENT_NAME",
-	6: "CUSTOM",
-	7: "THROWER_ENTITY_ID",
+	0:  "ENTITY_ID",
+	1:  "VICTIM",
+	2:  "KILLER",
+	3:  "ASSISTER",
+	4:  "TEXT",
+	5:  "EVENT_NAME",
+	6:  "CUSTOM",
+	7:  "THROWER_ENTITY_ID",
+	8:  "SITE",
+	9:  "PROJECTILE_ID",
+	10: "ITEM_ID",
+	11: "HOSTAGE_ID",
+	12: "WEAPON",
+	13: "IS_HEADSHOT",
+	14: "PENETRATED_OBJECTS",
+	15: "IS_NO_SCOPE",
+	16: "IS_THROUGH_SMOKE",
+	17: "IS_ATTACKER_BLIND",
+	18: "IS_ASSISTED_FLASH",
+	19: "DISTANCE",
+	20: "ATTACKER",
+	21: "HEALTH_DAMAGE",
+	22: "ARMOR_DAMAGE",
+	23: "HEALTH",
+	24: "ARMOR",
+	25: "HIT_GROUP",
 }
 
 var Replay_Tick_Event_Attribute_Kind_value = map[string]int32{
-	"ENTITY_ID":         0,
-	"VICTIM":            1,
-	"KILLER":            2,
-	"ASSISTER":          3,
-	"TEXT":              4,
-	"EVENT_NAME":        5,
-	"CUSTOM":            6,
-	"THROWER_ENTITY_ID": 7,
+	"ENTITY_ID":          0,
+	"VICTIM":             1,
+	"KILLER":             2,
+	"ASSISTER":           3,
+	"TEXT":               4,
+	"EVENT_NAME":         5,
+	"CUSTOM":             6,
+	"THROWER_ENTITY_ID":  7,
+	"SITE":               8,
+	"PROJECTILE_ID":      9,
+	"ITEM_ID":            10,
+	"HOSTAGE_ID":         11,
+	"WEAPON":             12,
+	"IS_HEADSHOT":        13,
+	"PENETRATED_OBJECTS": 14,
+	"IS_NO_SCOPE":        15,
+	"IS_THROUGH_SMOKE":   16,
+	"IS_ATTACKER_BLIND":  17,
+	"IS_ASSISTED_FLASH":  18,
+	"DISTANCE":           19,
+	"ATTACKER":           20,
+	"HEALTH_DAMAGE":      21,
+	"ARMOR_DAMAGE":       22,
+	"HEALTH":             23,
+	"ARMOR":              24,
+	"HIT_GROUP":          25,
 }
 
 func (x Replay_Tick_Event_Attribute_Kind) String() string {
@@ -173,7 +321,38 @@ func (x Replay_Tick_Event_Attribute_Kind) String() string {
 }
 
 func (Replay_Tick_Event_Attribute_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 0, 0, 0}
+	return fileDescriptor_eed9461330ccfc03, []int{1, 4, 0, 0, 0}
+}
+
+type Replay_Round_BombOutcome int32
+
+const (
+	Replay_Round_NONE     Replay_Round_BombOutcome = 0
+	Replay_Round_PLANTED  Replay_Round_BombOutcome = 1
+	Replay_Round_DEFUSED  Replay_Round_BombOutcome = 2
+	Replay_Round_EXPLODED Replay_Round_BombOutcome = 3
+)
+
+var Replay_Round_BombOutcome_name = map[int32]string{
+	0: "NONE",
+	1: "PLANTED",
+	2: "DEFUSED",
+	3: "EXPLODED",
+}
+
+var Replay_Round_BombOutcome_value = map[string]int32{
+	"NONE":     0,
+	"PLANTED":  1,
+	"DEFUSED":  2,
+	"EXPLODED": 3,
+}
+
+func (x Replay_Round_BombOutcome) String() string {
+	return proto.EnumName(Replay_Round_BombOutcome_name, int32(x))
+}
+
+func (Replay_Round_BombOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 6, 0}
 }
 
 type Point struct {
@@ -237,10 +416,14 @@ func (m *Point) GetZ() int32 {
 }
 
 type Replay struct {
-	Header    *Replay_Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Entities  []*Replay_Entity   `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
-	Snapshots []*Replay_Snapshot `protobuf:"bytes,3,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
-	Ticks     []*Replay_Tick     `protobuf:"bytes,4,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	Header      *Replay_Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Entities    []*Replay_Entity     `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	Snapshots   []*Replay_Snapshot   `protobuf:"bytes,3,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Ticks       []*Replay_Tick       `protobuf:"bytes,4,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	Warnings    []*Replay_Warning    `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Rounds      []*Replay_Round      `protobuf:"bytes,6,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	Projectiles []*Replay_Projectile `protobuf:"bytes,7,rep,name=projectiles,proto3" json:"projectiles,omitempty"`
+	Footer      *Replay_Footer       `protobuf:"bytes,8,opt,name=footer,proto3" json:"footer,omitempty"`
 }
 
 func (m *Replay) Reset()         { *m = Replay{} }
@@ -304,10 +487,48 @@ func (m *Replay) GetTicks() []*Replay_Tick {
 	return nil
 }
 
+func (m *Replay) GetWarnings() []*Replay_Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *Replay) GetRounds() []*Replay_Round {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func (m *Replay) GetProjectiles() []*Replay_Projectile {
+	if m != nil {
+		return m.Projectiles
+	}
+	return nil
+}
+
+func (m *Replay) GetFooter() *Replay_Footer {
+	if m != nil {
+		return m.Footer
+	}
+	return nil
+}
+
 type Replay_Header struct {
-	Map          string  `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
-	TickRate     float64 `protobuf:"fixed64,2,opt,name=tickRate,proto3" json:"tickRate,omitempty"`
-	SnapshotRate int32   `protobuf:"varint,3,opt,name=snapshotRate,proto3" json:"snapshotRate,omitempty"`
+	Map                  string  `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	TickRate             float64 `protobuf:"fixed64,2,opt,name=tickRate,proto3" json:"tickRate,omitempty"`
+	SnapshotRate         int32   `protobuf:"varint,3,opt,name=snapshotRate,proto3" json:"snapshotRate,omitempty"`
+	PositionSampleRate   int32   `protobuf:"varint,4,opt,name=positionSampleRate,proto3" json:"positionSampleRate,omitempty"`
+	ProjectileSampleRate int32   `protobuf:"varint,16,opt,name=projectileSampleRate,proto3" json:"projectileSampleRate,omitempty"`
+	ServerName           string  `protobuf:"bytes,7,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	ClientName           string  `protobuf:"bytes,8,opt,name=clientName,proto3" json:"clientName,omitempty"`
+	PlaybackTime         float64 `protobuf:"fixed64,9,opt,name=playbackTime,proto3" json:"playbackTime,omitempty"`
+	PlaybackTicks        int32   `protobuf:"varint,10,opt,name=playbackTicks,proto3" json:"playbackTicks,omitempty"`
+	PlaybackFrames       int32   `protobuf:"varint,11,opt,name=playbackFrames,proto3" json:"playbackFrames,omitempty"`
+	NetworkProtocol      int32   `protobuf:"varint,12,opt,name=networkProtocol,proto3" json:"networkProtocol,omitempty"`
+	Filestamp            string  `protobuf:"bytes,13,opt,name=filestamp,proto3" json:"filestamp,omitempty"`
+	MinifierVersion      string  `protobuf:"bytes,15,opt,name=minifierVersion,proto3" json:"minifierVersion,omitempty"`
 }
 
 func (m *Replay_Header) Reset()         { *m = Replay_Header{} }
@@ -364,25 +585,94 @@ func (m *Replay_Header) GetSnapshotRate() int32 {
 	return 0
 }
 
-type Replay_Entity struct {
-	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Team  Team   `protobuf:"varint,3,opt,name=team,proto3,enum=gen.Team" json:"team,omitempty"`
-	IsNpc bool   `protobuf:"varint,4,opt,name=isNpc,proto3" json:"isNpc,omitempty"`
+func (m *Replay_Header) GetPositionSampleRate() int32 {
+	if m != nil {
+		return m.PositionSampleRate
+	}
+	return 0
 }
 
-func (m *Replay_Entity) Reset()         { *m = Replay_Entity{} }
-func (m *Replay_Entity) String() string { return proto.CompactTextString(m) }
-func (*Replay_Entity) ProtoMessage()    {}
-func (*Replay_Entity) Descriptor() ([]byte, []int) {
+func (m *Replay_Header) GetProjectileSampleRate() int32 {
+	if m != nil {
+		return m.ProjectileSampleRate
+	}
+	return 0
+}
+
+func (m *Replay_Header) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *Replay_Header) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+func (m *Replay_Header) GetPlaybackTime() float64 {
+	if m != nil {
+		return m.PlaybackTime
+	}
+	return 0
+}
+
+func (m *Replay_Header) GetPlaybackTicks() int32 {
+	if m != nil {
+		return m.PlaybackTicks
+	}
+	return 0
+}
+
+func (m *Replay_Header) GetPlaybackFrames() int32 {
+	if m != nil {
+		return m.PlaybackFrames
+	}
+	return 0
+}
+
+func (m *Replay_Header) GetNetworkProtocol() int32 {
+	if m != nil {
+		return m.NetworkProtocol
+	}
+	return 0
+}
+
+func (m *Replay_Header) GetFilestamp() string {
+	if m != nil {
+		return m.Filestamp
+	}
+	return ""
+}
+
+func (m *Replay_Header) GetMinifierVersion() string {
+	if m != nil {
+		return m.MinifierVersion
+	}
+	return ""
+}
+
+type Replay_Footer struct {
+	Teams  []*Replay_Footer_Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Scores []*Replay_Footer_Score `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	Sha256 string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *Replay_Footer) Reset()         { *m = Replay_Footer{} }
+func (m *Replay_Footer) String() string { return proto.CompactTextString(m) }
+func (*Replay_Footer) ProtoMessage()    {}
+func (*Replay_Footer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eed9461330ccfc03, []int{1, 1}
 }
-func (m *Replay_Entity) XXX_Unmarshal(b []byte) error {
+func (m *Replay_Footer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Footer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Entity.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Footer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -392,63 +682,57 @@ func (m *Replay_Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Replay_Entity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Entity.Merge(m, src)
+func (m *Replay_Footer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Footer.Merge(m, src)
 }
-func (m *Replay_Entity) XXX_Size() int {
+func (m *Replay_Footer) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Entity) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Entity.DiscardUnknown(m)
+func (m *Replay_Footer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Footer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Entity proto.InternalMessageInfo
-
-func (m *Replay_Entity) GetId() int32 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
+var xxx_messageInfo_Replay_Footer proto.InternalMessageInfo
 
-func (m *Replay_Entity) GetName() string {
+func (m *Replay_Footer) GetTeams() []*Replay_Footer_Team {
 	if m != nil {
-		return m.Name
+		return m.Teams
 	}
-	return ""
+	return nil
 }
 
-func (m *Replay_Entity) GetTeam() Team {
+func (m *Replay_Footer) GetScores() []*Replay_Footer_Score {
 	if m != nil {
-		return m.Team
+		return m.Scores
 	}
-	return Team_UNASSIGNED
+	return nil
 }
 
-func (m *Replay_Entity) GetIsNpc() bool {
+func (m *Replay_Footer) GetSha256() string {
 	if m != nil {
-		return m.IsNpc
+		return m.Sha256
 	}
-	return false
+	return ""
 }
 
-type Replay_Snapshot struct {
-	Tick          int32                           `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
-	EntityUpdates []*Replay_Snapshot_EntityUpdate `protobuf:"bytes,2,rep,name=entityUpdates,proto3" json:"entityUpdates,omitempty"`
+type Replay_Footer_Team struct {
+	ClanName string `protobuf:"bytes,1,opt,name=clanName,proto3" json:"clanName,omitempty"`
+	Flag     string `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Sides    []Team `protobuf:"varint,3,rep,packed,name=sides,proto3,enum=gen.Team" json:"sides,omitempty"`
 }
 
-func (m *Replay_Snapshot) Reset()         { *m = Replay_Snapshot{} }
-func (m *Replay_Snapshot) String() string { return proto.CompactTextString(m) }
-func (*Replay_Snapshot) ProtoMessage()    {}
-func (*Replay_Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 2}
+func (m *Replay_Footer_Team) Reset()         { *m = Replay_Footer_Team{} }
+func (m *Replay_Footer_Team) String() string { return proto.CompactTextString(m) }
+func (*Replay_Footer_Team) ProtoMessage()    {}
+func (*Replay_Footer_Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 1, 0}
 }
-func (m *Replay_Snapshot) XXX_Unmarshal(b []byte) error {
+func (m *Replay_Footer_Team) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Footer_Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Snapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Footer_Team.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -458,50 +742,58 @@ func (m *Replay_Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *Replay_Snapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Snapshot.Merge(m, src)
+func (m *Replay_Footer_Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Footer_Team.Merge(m, src)
 }
-func (m *Replay_Snapshot) XXX_Size() int {
+func (m *Replay_Footer_Team) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Snapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Snapshot.DiscardUnknown(m)
+func (m *Replay_Footer_Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Footer_Team.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Snapshot proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Footer_Team proto.InternalMessageInfo
 
-func (m *Replay_Snapshot) GetTick() int32 {
+func (m *Replay_Footer_Team) GetClanName() string {
 	if m != nil {
-		return m.Tick
+		return m.ClanName
 	}
-	return 0
+	return ""
 }
 
-func (m *Replay_Snapshot) GetEntityUpdates() []*Replay_Snapshot_EntityUpdate {
+func (m *Replay_Footer_Team) GetFlag() string {
 	if m != nil {
-		return m.EntityUpdates
+		return m.Flag
+	}
+	return ""
+}
+
+func (m *Replay_Footer_Team) GetSides() []Team {
+	if m != nil {
+		return m.Sides
 	}
 	return nil
 }
 
-type Replay_Snapshot_EntityEquipment struct {
-	Type           int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	AmmoReserve    int32 `protobuf:"varint,2,opt,name=ammoReserve,proto3" json:"ammoReserve,omitempty"`
-	AmmoInMagazine int32 `protobuf:"varint,3,opt,name=ammoInMagazine,proto3" json:"ammoInMagazine,omitempty"`
+type Replay_Footer_Score struct {
+	Tick              int32 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Round             int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Terrorists        int32 `protobuf:"varint,3,opt,name=terrorists,proto3" json:"terrorists,omitempty"`
+	CounterTerrorists int32 `protobuf:"varint,4,opt,name=counterTerrorists,proto3" json:"counterTerrorists,omitempty"`
 }
 
-func (m *Replay_Snapshot_EntityEquipment) Reset()         { *m = Replay_Snapshot_EntityEquipment{} }
-func (m *Replay_Snapshot_EntityEquipment) String() string { return proto.CompactTextString(m) }
-func (*Replay_Snapshot_EntityEquipment) ProtoMessage()    {}
-func (*Replay_Snapshot_EntityEquipment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 2, 0}
+func (m *Replay_Footer_Score) Reset()         { *m = Replay_Footer_Score{} }
+func (m *Replay_Footer_Score) String() string { return proto.CompactTextString(m) }
+func (*Replay_Footer_Score) ProtoMessage()    {}
+func (*Replay_Footer_Score) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 1, 1}
 }
-func (m *Replay_Snapshot_EntityEquipment) XXX_Unmarshal(b []byte) error {
+func (m *Replay_Footer_Score) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Snapshot_EntityEquipment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Footer_Score) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Snapshot_EntityEquipment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Footer_Score.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -511,66 +803,74 @@ func (m *Replay_Snapshot_EntityEquipment) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *Replay_Snapshot_EntityEquipment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Snapshot_EntityEquipment.Merge(m, src)
+func (m *Replay_Footer_Score) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Footer_Score.Merge(m, src)
 }
-func (m *Replay_Snapshot_EntityEquipment) XXX_Size() int {
+func (m *Replay_Footer_Score) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Snapshot_EntityEquipment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Snapshot_EntityEquipment.DiscardUnknown(m)
+func (m *Replay_Footer_Score) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Footer_Score.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Snapshot_EntityEquipment proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Footer_Score proto.InternalMessageInfo
 
-func (m *Replay_Snapshot_EntityEquipment) GetType() int32 {
+func (m *Replay_Footer_Score) GetTick() int32 {
 	if m != nil {
-		return m.Type
+		return m.Tick
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityEquipment) GetAmmoReserve() int32 {
+func (m *Replay_Footer_Score) GetRound() int32 {
 	if m != nil {
-		return m.AmmoReserve
+		return m.Round
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityEquipment) GetAmmoInMagazine() int32 {
+func (m *Replay_Footer_Score) GetTerrorists() int32 {
 	if m != nil {
-		return m.AmmoInMagazine
+		return m.Terrorists
 	}
 	return 0
 }
 
-type Replay_Snapshot_EntityUpdate struct {
-	EntityId      int32                              `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
-	Positions     []*Point                           `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
-	AngleX        int32                              `protobuf:"varint,3,opt,name=angleX,proto3" json:"angleX,omitempty"`
-	Hp            int32                              `protobuf:"varint,4,opt,name=hp,proto3" json:"hp,omitempty"`
-	Armor         int32                              `protobuf:"varint,5,opt,name=armor,proto3" json:"armor,omitempty"`
-	FlashDuration float32                            `protobuf:"fixed32,6,opt,name=flashDuration,proto3" json:"flashDuration,omitempty"`
-	Team          Team                               `protobuf:"varint,7,opt,name=team,proto3,enum=gen.Team" json:"team,omitempty"`
-	IsNpc         bool                               `protobuf:"varint,8,opt,name=isNpc,proto3" json:"isNpc,omitempty"`
-	AngleY        int32                              `protobuf:"varint,9,opt,name=angleY,proto3" json:"angleY,omitempty"`
-	HasHelmet     bool                               `protobuf:"varint,10,opt,name=hasHelmet,proto3" json:"hasHelmet,omitempty"`
-	HasDefuseKit  bool                               `protobuf:"varint,11,opt,name=hasDefuseKit,proto3" json:"hasDefuseKit,omitempty"`
-	Equipment     []*Replay_Snapshot_EntityEquipment `protobuf:"bytes,12,rep,name=equipment,proto3" json:"equipment,omitempty"`
+func (m *Replay_Footer_Score) GetCounterTerrorists() int32 {
+	if m != nil {
+		return m.CounterTerrorists
+	}
+	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) Reset()         { *m = Replay_Snapshot_EntityUpdate{} }
-func (m *Replay_Snapshot_EntityUpdate) String() string { return proto.CompactTextString(m) }
-func (*Replay_Snapshot_EntityUpdate) ProtoMessage()    {}
-func (*Replay_Snapshot_EntityUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 2, 1}
+type Replay_Entity struct {
+	Id              int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Team            Team   `protobuf:"varint,3,opt,name=team,proto3,enum=gen.Team" json:"team,omitempty"`
+	IsNpc           bool   `protobuf:"varint,4,opt,name=isNpc,proto3" json:"isNpc,omitempty"`
+	SteamId64       uint64 `protobuf:"varint,5,opt,name=steamId64,proto3" json:"steamId64,omitempty"`
+	AccountId       uint32 `protobuf:"varint,6,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	ClanTag         string `protobuf:"bytes,7,opt,name=clanTag,proto3" json:"clanTag,omitempty"`
+	Rank            int32  `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`
+	CompetitiveWins int32  `protobuf:"varint,9,opt,name=competitiveWins,proto3" json:"competitiveWins,omitempty"`
+	CrosshairCode   string `protobuf:"bytes,10,opt,name=crosshairCode,proto3" json:"crosshairCode,omitempty"`
+	Tick            int32  `protobuf:"varint,11,opt,name=tick,proto3" json:"tick,omitempty"`
+	JoinTick        int32  `protobuf:"varint,12,opt,name=joinTick,proto3" json:"joinTick,omitempty"`
+	LeaveTick       int32  `protobuf:"varint,13,opt,name=leaveTick,proto3" json:"leaveTick,omitempty"`
 }
-func (m *Replay_Snapshot_EntityUpdate) XXX_Unmarshal(b []byte) error {
+
+func (m *Replay_Entity) Reset()         { *m = Replay_Entity{} }
+func (m *Replay_Entity) String() string { return proto.CompactTextString(m) }
+func (*Replay_Entity) ProtoMessage()    {}
+func (*Replay_Entity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 2}
+}
+func (m *Replay_Entity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Snapshot_EntityUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Snapshot_EntityUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Entity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -580,119 +880,132 @@ func (m *Replay_Snapshot_EntityUpdate) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *Replay_Snapshot_EntityUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Snapshot_EntityUpdate.Merge(m, src)
+func (m *Replay_Entity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Entity.Merge(m, src)
 }
-func (m *Replay_Snapshot_EntityUpdate) XXX_Size() int {
+func (m *Replay_Entity) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Snapshot_EntityUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Snapshot_EntityUpdate.DiscardUnknown(m)
+func (m *Replay_Entity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Entity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Snapshot_EntityUpdate proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Entity proto.InternalMessageInfo
 
-func (m *Replay_Snapshot_EntityUpdate) GetEntityId() int32 {
+func (m *Replay_Entity) GetId() int32 {
 	if m != nil {
-		return m.EntityId
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetPositions() []*Point {
+func (m *Replay_Entity) GetName() string {
 	if m != nil {
-		return m.Positions
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetAngleX() int32 {
+func (m *Replay_Entity) GetTeam() Team {
 	if m != nil {
-		return m.AngleX
+		return m.Team
 	}
-	return 0
+	return Team_UNASSIGNED
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetHp() int32 {
+func (m *Replay_Entity) GetIsNpc() bool {
 	if m != nil {
-		return m.Hp
+		return m.IsNpc
 	}
-	return 0
+	return false
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetArmor() int32 {
+func (m *Replay_Entity) GetSteamId64() uint64 {
 	if m != nil {
-		return m.Armor
+		return m.SteamId64
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetFlashDuration() float32 {
+func (m *Replay_Entity) GetAccountId() uint32 {
 	if m != nil {
-		return m.FlashDuration
+		return m.AccountId
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetTeam() Team {
+func (m *Replay_Entity) GetClanTag() string {
 	if m != nil {
-		return m.Team
+		return m.ClanTag
 	}
-	return Team_UNASSIGNED
+	return ""
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetIsNpc() bool {
+func (m *Replay_Entity) GetRank() int32 {
 	if m != nil {
-		return m.IsNpc
+		return m.Rank
 	}
-	return false
+	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetAngleY() int32 {
+func (m *Replay_Entity) GetCompetitiveWins() int32 {
 	if m != nil {
-		return m.AngleY
+		return m.CompetitiveWins
 	}
 	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetHasHelmet() bool {
+func (m *Replay_Entity) GetCrosshairCode() string {
 	if m != nil {
-		return m.HasHelmet
+		return m.CrosshairCode
 	}
-	return false
+	return ""
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetHasDefuseKit() bool {
+func (m *Replay_Entity) GetTick() int32 {
 	if m != nil {
-		return m.HasDefuseKit
+		return m.Tick
 	}
-	return false
+	return 0
 }
 
-func (m *Replay_Snapshot_EntityUpdate) GetEquipment() []*Replay_Snapshot_EntityEquipment {
+func (m *Replay_Entity) GetJoinTick() int32 {
 	if m != nil {
-		return m.Equipment
+		return m.JoinTick
 	}
-	return nil
+	return 0
 }
 
-type Replay_Tick struct {
-	Nr     int32                `protobuf:"varint,1,opt,name=nr,proto3" json:"nr,omitempty"`
-	Events []*Replay_Tick_Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+func (m *Replay_Entity) GetLeaveTick() int32 {
+	if m != nil {
+		return m.LeaveTick
+	}
+	return 0
 }
 
-func (m *Replay_Tick) Reset()         { *m = Replay_Tick{} }
-func (m *Replay_Tick) String() string { return proto.CompactTextString(m) }
-func (*Replay_Tick) ProtoMessage()    {}
-func (*Replay_Tick) Descriptor() ([]byte, []int) {
+type Replay_Snapshot struct {
+	Tick             int32                           `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	EntityUpdates    []*Replay_Snapshot_EntityUpdate `protobuf:"bytes,2,rep,name=entityUpdates,proto3" json:"entityUpdates,omitempty"`
+	Delta            bool                            `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	RemovedEntityIds []int32                         `protobuf:"varint,4,rep,packed,name=removedEntityIds,proto3" json:"removedEntityIds,omitempty"`
+	Bomb             *Replay_Snapshot_Bomb           `protobuf:"bytes,5,opt,name=bomb,proto3" json:"bomb,omitempty"`
+	Infernos         []*Replay_Snapshot_Inferno      `protobuf:"bytes,6,rep,name=infernos,proto3" json:"infernos,omitempty"`
+	Items            []*Replay_Snapshot_Item         `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	Hostages         []*Replay_Snapshot_Hostage      `protobuf:"bytes,8,rep,name=hostages,proto3" json:"hostages,omitempty"`
+}
+
+func (m *Replay_Snapshot) Reset()         { *m = Replay_Snapshot{} }
+func (m *Replay_Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot) ProtoMessage()    {}
+func (*Replay_Snapshot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eed9461330ccfc03, []int{1, 3}
 }
-func (m *Replay_Tick) XXX_Unmarshal(b []byte) error {
+func (m *Replay_Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Tick.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Snapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -702,49 +1015,92 @@ func (m *Replay_Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Replay_Tick) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Tick.Merge(m, src)
+func (m *Replay_Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot.Merge(m, src)
 }
-func (m *Replay_Tick) XXX_Size() int {
+func (m *Replay_Snapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Tick) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Tick.DiscardUnknown(m)
+func (m *Replay_Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Tick proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Snapshot proto.InternalMessageInfo
 
-func (m *Replay_Tick) GetNr() int32 {
+func (m *Replay_Snapshot) GetTick() int32 {
 	if m != nil {
-		return m.Nr
+		return m.Tick
 	}
 	return 0
 }
 
-func (m *Replay_Tick) GetEvents() []*Replay_Tick_Event {
+func (m *Replay_Snapshot) GetEntityUpdates() []*Replay_Snapshot_EntityUpdate {
 	if m != nil {
-		return m.Events
+		return m.EntityUpdates
 	}
 	return nil
 }
 
-type Replay_Tick_Event struct {
-	Kind       Replay_Tick_Event_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=gen.Replay_Tick_Event_Kind" json:"kind,omitempty"`
-	Attributes []*Replay_Tick_Event_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+func (m *Replay_Snapshot) GetDelta() bool {
+	if m != nil {
+		return m.Delta
+	}
+	return false
 }
 
-func (m *Replay_Tick_Event) Reset()         { *m = Replay_Tick_Event{} }
-func (m *Replay_Tick_Event) String() string { return proto.CompactTextString(m) }
-func (*Replay_Tick_Event) ProtoMessage()    {}
-func (*Replay_Tick_Event) Descriptor() ([]byte, []int) {
+func (m *Replay_Snapshot) GetRemovedEntityIds() []int32 {
+	if m != nil {
+		return m.RemovedEntityIds
+	}
+	return nil
+}
+
+func (m *Replay_Snapshot) GetBomb() *Replay_Snapshot_Bomb {
+	if m != nil {
+		return m.Bomb
+	}
+	return nil
+}
+
+func (m *Replay_Snapshot) GetInfernos() []*Replay_Snapshot_Inferno {
+	if m != nil {
+		return m.Infernos
+	}
+	return nil
+}
+
+func (m *Replay_Snapshot) GetItems() []*Replay_Snapshot_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Replay_Snapshot) GetHostages() []*Replay_Snapshot_Hostage {
+	if m != nil {
+		return m.Hostages
+	}
+	return nil
+}
+
+type Replay_Snapshot_EntityEquipment struct {
+	Type           int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	AmmoReserve    int32 `protobuf:"varint,2,opt,name=ammoReserve,proto3" json:"ammoReserve,omitempty"`
+	AmmoInMagazine int32 `protobuf:"varint,3,opt,name=ammoInMagazine,proto3" json:"ammoInMagazine,omitempty"`
+}
+
+func (m *Replay_Snapshot_EntityEquipment) Reset()         { *m = Replay_Snapshot_EntityEquipment{} }
+func (m *Replay_Snapshot_EntityEquipment) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_EntityEquipment) ProtoMessage()    {}
+func (*Replay_Snapshot_EntityEquipment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 0}
 }
-func (m *Replay_Tick_Event) XXX_Unmarshal(b []byte) error {
+func (m *Replay_Snapshot_EntityEquipment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Tick_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Snapshot_EntityEquipment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Tick_Event.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Snapshot_EntityEquipment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -754,51 +1110,58 @@ func (m *Replay_Tick_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *Replay_Tick_Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Tick_Event.Merge(m, src)
+func (m *Replay_Snapshot_EntityEquipment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_EntityEquipment.Merge(m, src)
 }
-func (m *Replay_Tick_Event) XXX_Size() int {
+func (m *Replay_Snapshot_EntityEquipment) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Tick_Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Tick_Event.DiscardUnknown(m)
+func (m *Replay_Snapshot_EntityEquipment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_EntityEquipment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Tick_Event proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Snapshot_EntityEquipment proto.InternalMessageInfo
 
-func (m *Replay_Tick_Event) GetKind() Replay_Tick_Event_Kind {
+func (m *Replay_Snapshot_EntityEquipment) GetType() int32 {
 	if m != nil {
-		return m.Kind
+		return m.Type
 	}
-	return Replay_Tick_Event_JUMP
+	return 0
 }
 
-func (m *Replay_Tick_Event) GetAttributes() []*Replay_Tick_Event_Attribute {
+func (m *Replay_Snapshot_EntityEquipment) GetAmmoReserve() int32 {
 	if m != nil {
-		return m.Attributes
+		return m.AmmoReserve
 	}
-	return nil
+	return 0
 }
 
-type Replay_Tick_Event_Attribute struct {
-	Kind        Replay_Tick_Event_Attribute_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=gen.Replay_Tick_Event_Attribute_Kind" json:"kind,omitempty"`
-	StringValue string                           `protobuf:"bytes,2,opt,name=stringValue,proto3" json:"stringValue,omitempty"`
-	NumberValue float64                          `protobuf:"fixed64,3,opt,name=numberValue,proto3" json:"numberValue,omitempty"`
-	CustomName  string                           `protobuf:"bytes,4,opt,name=customName,proto3" json:"customName,omitempty"`
+func (m *Replay_Snapshot_EntityEquipment) GetAmmoInMagazine() int32 {
+	if m != nil {
+		return m.AmmoInMagazine
+	}
+	return 0
 }
 
-func (m *Replay_Tick_Event_Attribute) Reset()         { *m = Replay_Tick_Event_Attribute{} }
-func (m *Replay_Tick_Event_Attribute) String() string { return proto.CompactTextString(m) }
-func (*Replay_Tick_Event_Attribute) ProtoMessage()    {}
-func (*Replay_Tick_Event_Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 0, 0}
+type Replay_Snapshot_EntityEconomy struct {
+	Money               int32 `protobuf:"varint,1,opt,name=money,proto3" json:"money,omitempty"`
+	EquipmentValue      int32 `protobuf:"varint,2,opt,name=equipmentValue,proto3" json:"equipmentValue,omitempty"`
+	MoneySpentThisRound int32 `protobuf:"varint,3,opt,name=moneySpentThisRound,proto3" json:"moneySpentThisRound,omitempty"`
+	StartMoney          int32 `protobuf:"varint,4,opt,name=startMoney,proto3" json:"startMoney,omitempty"`
 }
-func (m *Replay_Tick_Event_Attribute) XXX_Unmarshal(b []byte) error {
+
+func (m *Replay_Snapshot_EntityEconomy) Reset()         { *m = Replay_Snapshot_EntityEconomy{} }
+func (m *Replay_Snapshot_EntityEconomy) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_EntityEconomy) ProtoMessage()    {}
+func (*Replay_Snapshot_EntityEconomy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 1}
+}
+func (m *Replay_Snapshot_EntityEconomy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Replay_Tick_Event_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Replay_Snapshot_EntityEconomy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Replay_Tick_Event_Attribute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Replay_Snapshot_EntityEconomy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -808,921 +1171,3567 @@ func (m *Replay_Tick_Event_Attribute) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *Replay_Tick_Event_Attribute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Replay_Tick_Event_Attribute.Merge(m, src)
+func (m *Replay_Snapshot_EntityEconomy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_EntityEconomy.Merge(m, src)
 }
-func (m *Replay_Tick_Event_Attribute) XXX_Size() int {
+func (m *Replay_Snapshot_EntityEconomy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Replay_Tick_Event_Attribute) XXX_DiscardUnknown() {
-	xxx_messageInfo_Replay_Tick_Event_Attribute.DiscardUnknown(m)
+func (m *Replay_Snapshot_EntityEconomy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_EntityEconomy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Replay_Tick_Event_Attribute proto.InternalMessageInfo
+var xxx_messageInfo_Replay_Snapshot_EntityEconomy proto.InternalMessageInfo
 
-func (m *Replay_Tick_Event_Attribute) GetKind() Replay_Tick_Event_Attribute_Kind {
+func (m *Replay_Snapshot_EntityEconomy) GetMoney() int32 {
 	if m != nil {
-		return m.Kind
+		return m.Money
 	}
-	return Replay_Tick_Event_Attribute_ENTITY_ID
+	return 0
 }
 
-func (m *Replay_Tick_Event_Attribute) GetStringValue() string {
+func (m *Replay_Snapshot_EntityEconomy) GetEquipmentValue() int32 {
 	if m != nil {
-		return m.StringValue
+		return m.EquipmentValue
 	}
-	return ""
+	return 0
 }
 
-func (m *Replay_Tick_Event_Attribute) GetNumberValue() float64 {
+func (m *Replay_Snapshot_EntityEconomy) GetMoneySpentThisRound() int32 {
 	if m != nil {
-		return m.NumberValue
+		return m.MoneySpentThisRound
 	}
 	return 0
 }
 
-func (m *Replay_Tick_Event_Attribute) GetCustomName() string {
+func (m *Replay_Snapshot_EntityEconomy) GetStartMoney() int32 {
 	if m != nil {
-		return m.CustomName
+		return m.StartMoney
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("gen.Team", Team_name, Team_value)
-	proto.RegisterEnum("gen.Replay_Tick_Event_Kind", Replay_Tick_Event_Kind_name, Replay_Tick_Event_Kind_value)
-	proto.RegisterEnum("gen.Replay_Tick_Event_Attribute_Kind", Replay_Tick_Event_Attribute_Kind_name, Replay_Tick_Event_Attribute_Kind_value)
-	proto.RegisterType((*Point)(nil), "gen.Point")
-	proto.RegisterType((*Replay)(nil), "gen.Replay")
-	proto.RegisterType((*Replay_Header)(nil), "gen.Replay.Header")
-	proto.RegisterType((*Replay_Entity)(nil), "gen.Replay.Entity")
-	proto.RegisterType((*Replay_Snapshot)(nil), "gen.Replay.Snapshot")
-	proto.RegisterType((*Replay_Snapshot_EntityEquipment)(nil), "gen.Replay.Snapshot.EntityEquipment")
-	proto.RegisterType((*Replay_Snapshot_EntityUpdate)(nil), "gen.Replay.Snapshot.EntityUpdate")
-	proto.RegisterType((*Replay_Tick)(nil), "gen.Replay.Tick")
-	proto.RegisterType((*Replay_Tick_Event)(nil), "gen.Replay.Tick.Event")
-	proto.RegisterType((*Replay_Tick_Event_Attribute)(nil), "gen.Replay.Tick.Event.Attribute")
+type Replay_Snapshot_EntityStats struct {
+	Kills   int32 `protobuf:"varint,1,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths  int32 `protobuf:"varint,2,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists int32 `protobuf:"varint,3,opt,name=assists,proto3" json:"assists,omitempty"`
+	Mvps    int32 `protobuf:"varint,4,opt,name=mvps,proto3" json:"mvps,omitempty"`
+	Score   int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Ping    int32 `protobuf:"varint,6,opt,name=ping,proto3" json:"ping,omitempty"`
 }
 
-func init() { proto.RegisterFile("replay.proto", fileDescriptor_eed9461330ccfc03) }
-
-var fileDescriptor_eed9461330ccfc03 = []byte{
-	// 1047 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0x35, 0x25, 0x8a, 0x16, 0xaf, 0x7e, 0x3c, 0x9e, 0xf8, 0x33, 0x08, 0x7d, 0xad, 0xa0, 0x1a,
-	0x69, 0x60, 0x64, 0xa1, 0xa2, 0xee, 0xaa, 0xbb, 0x32, 0xd2, 0x44, 0x62, 0x6d, 0x51, 0xc2, 0x70,
-	0xe4, 0xd8, 0xdd, 0x08, 0xb4, 0x3d, 0xb1, 0x08, 0x5b, 0x14, 0x4b, 0x52, 0x81, 0xe5, 0x37, 0x28,
-	0xba, 0xe9, 0x43, 0xf4, 0x35, 0xba, 0xef, 0x32, 0xe8, 0xaa, 0xcb, 0xc2, 0x46, 0xfb, 0x1c, 0xc5,
-	0x0c, 0x45, 0x8a, 0x72, 0xd3, 0x66, 0x37, 0xf7, 0xdc, 0x73, 0xff, 0xce, 0x9d, 0x21, 0xa1, 0x1a,
-	0xf2, 0xe0, 0xd6, 0x5d, 0xb6, 0x83, 0x70, 0x1e, 0xcf, 0x71, 0xf1, 0x9a, 0xfb, 0x07, 0x5f, 0x42,
-	0x69, 0x34, 0xf7, 0xfc, 0x18, 0x57, 0x41, 0xb9, 0x33, 0x94, 0x96, 0x72, 0x58, 0xa2, 0xca, 0x9d,
-	0xb0, 0x96, 0x46, 0x21, 0xb1, 0x96, 0xc2, 0xba, 0x37, 0x8a, 0x89, 0x75, 0x7f, 0xf0, 0x4b, 0x1d,
-	0x34, 0x2a, 0x13, 0xe1, 0x97, 0xa0, 0x4d, 0xb9, 0x7b, 0xc5, 0x43, 0x19, 0x59, 0x39, 0xc2, 0xed,
-	0x6b, 0xee, 0xb7, 0x13, 0x67, 0xbb, 0x2f, 0x3d, 0x74, 0xc5, 0xc0, 0x6d, 0x28, 0x73, 0x3f, 0xf6,
-	0x62, 0x8f, 0x47, 0x46, 0xa1, 0x55, 0x7c, 0xca, 0x26, 0xc2, 0xb7, 0xa4, 0x19, 0x07, 0x1f, 0x81,
-	0x1e, 0xf9, 0x6e, 0x10, 0x4d, 0xe7, 0x71, 0x64, 0x14, 0x65, 0xc0, 0x5e, 0x3e, 0xc0, 0x59, 0x39,
-	0xe9, 0x9a, 0x86, 0x5f, 0x40, 0x29, 0xf6, 0x2e, 0x6f, 0x22, 0x43, 0x95, 0x7c, 0x94, 0xe7, 0x33,
-	0xef, 0xf2, 0x86, 0x26, 0xee, 0xc6, 0x77, 0xa0, 0x25, 0xdd, 0x61, 0x04, 0xc5, 0x99, 0x1b, 0xc8,
-	0xf6, 0x75, 0x2a, 0x8e, 0xb8, 0x01, 0x65, 0x41, 0xa2, 0x6e, 0xcc, 0xa5, 0x02, 0x0a, 0xcd, 0x6c,
-	0x7c, 0x00, 0xd5, 0xb4, 0x98, 0xf4, 0x27, 0x9a, 0x6c, 0x60, 0x0d, 0x17, 0xb4, 0x64, 0x16, 0x5c,
-	0x87, 0x82, 0x77, 0xb5, 0xd2, 0xb4, 0xe0, 0x5d, 0x61, 0x0c, 0xaa, 0xef, 0xce, 0x92, 0xac, 0x3a,
-	0x95, 0x67, 0xfc, 0x29, 0xa8, 0x31, 0x77, 0x67, 0x32, 0x53, 0xfd, 0x48, 0x97, 0x0d, 0x33, 0xee,
-	0xce, 0xa8, 0x84, 0xf1, 0x1e, 0x94, 0xbc, 0xc8, 0x0e, 0x2e, 0x0d, 0xb5, 0xa5, 0x1c, 0x96, 0x69,
-	0x62, 0x34, 0xfe, 0x52, 0xa1, 0x9c, 0x8e, 0x2f, 0xb2, 0x8a, 0xfe, 0x56, 0x75, 0xe4, 0x19, 0xf7,
-	0xa0, 0x26, 0x75, 0x5c, 0x8e, 0x83, 0x2b, 0x37, 0xce, 0x04, 0xff, 0xec, 0x43, 0xfa, 0xad, 0x94,
-	0x4f, 0x98, 0x74, 0x33, 0xae, 0x31, 0x87, 0x9d, 0xc4, 0x4d, 0xbe, 0x5f, 0x78, 0xc1, 0x8c, 0xfb,
-	0x49, 0xbd, 0x65, 0xc0, 0xb3, 0x7a, 0xcb, 0x80, 0xe3, 0x16, 0x54, 0xdc, 0xd9, 0x6c, 0x4e, 0x79,
-	0xc4, 0xc3, 0x77, 0x7c, 0x75, 0x71, 0xf2, 0x10, 0x7e, 0x01, 0x75, 0x61, 0x5a, 0xfe, 0xc0, 0xbd,
-	0x76, 0xef, 0x3d, 0x3f, 0xd5, 0xee, 0x09, 0xda, 0xf8, 0xb1, 0x08, 0xd5, 0x7c, 0x43, 0x62, 0x1d,
-	0x49, 0x4b, 0x56, 0x2a, 0x65, 0x66, 0xe3, 0x43, 0xd0, 0x83, 0x79, 0xe4, 0xc5, 0xde, 0xdc, 0x4f,
-	0x47, 0x04, 0x39, 0xa2, 0xbc, 0xd2, 0x74, 0xed, 0xc4, 0xfb, 0xa0, 0xb9, 0xfe, 0xf5, 0x2d, 0x3f,
-	0x5b, 0x95, 0x5d, 0x59, 0x62, 0x45, 0xd3, 0x40, 0x8a, 0x5b, 0xa2, 0x85, 0x69, 0x20, 0xf4, 0x76,
-	0xc3, 0xd9, 0x3c, 0x34, 0x4a, 0x12, 0x4a, 0x0c, 0xfc, 0x1c, 0x6a, 0x6f, 0x6f, 0xdd, 0x68, 0xda,
-	0x5d, 0x84, 0xae, 0xc8, 0x67, 0x68, 0x2d, 0xe5, 0xb0, 0x40, 0x37, 0xc1, 0x6c, 0x95, 0xdb, 0x1f,
-	0x59, 0x65, 0x39, 0xb7, 0xca, 0xac, 0xb1, 0x73, 0x43, 0xcf, 0x35, 0x76, 0x8e, 0x3f, 0x01, 0x7d,
-	0xea, 0x46, 0x7d, 0x7e, 0x3b, 0xe3, 0xb1, 0x01, 0x32, 0x62, 0x0d, 0x88, 0x7b, 0x38, 0x75, 0xa3,
-	0x2e, 0x7f, 0xbb, 0x88, 0xf8, 0xb1, 0x17, 0x1b, 0x15, 0x49, 0xd8, 0xc0, 0xf0, 0x2b, 0xd0, 0x79,
-	0xba, 0x34, 0xa3, 0x2a, 0xc5, 0x79, 0xfe, 0x1f, 0xfb, 0xcf, 0x16, 0x4c, 0xd7, 0x61, 0x8d, 0xdf,
-	0x34, 0x50, 0xc5, 0xbb, 0x11, 0x3a, 0xf9, 0x61, 0x7a, 0x95, 0x7d, 0xf1, 0x98, 0x35, 0xfe, 0x8e,
-	0xfb, 0x71, 0x2a, 0xfb, 0xfe, 0xd3, 0x97, 0xd6, 0x26, 0xc2, 0x4d, 0x57, 0xac, 0xc6, 0x0f, 0x1a,
-	0x94, 0x24, 0x82, 0xbf, 0x00, 0xf5, 0xc6, 0xf3, 0x93, 0x5d, 0xd6, 0x8f, 0xfe, 0xff, 0xe1, 0xb8,
-	0xf6, 0xb1, 0xe7, 0x5f, 0x51, 0x49, 0xc4, 0xdf, 0x00, 0xb8, 0x71, 0x1c, 0x7a, 0x17, 0x8b, 0xf5,
-	0x45, 0x6e, 0xfd, 0x4b, 0x98, 0x99, 0x12, 0x69, 0x2e, 0xa6, 0xf1, 0x73, 0x01, 0xf4, 0xcc, 0x83,
-	0xbf, 0xde, 0x68, 0xe0, 0xf3, 0x8f, 0x65, 0xca, 0xb7, 0xd2, 0x82, 0x4a, 0x14, 0x87, 0x9e, 0x7f,
-	0x7d, 0xea, 0xde, 0x2e, 0xd2, 0x77, 0x9c, 0x87, 0x04, 0xc3, 0x5f, 0xcc, 0x2e, 0x78, 0x98, 0x30,
-	0x8a, 0xf2, 0xfb, 0x91, 0x87, 0x70, 0x13, 0xe0, 0x72, 0x11, 0xc5, 0xf3, 0x99, 0x2d, 0x3e, 0x05,
-	0xaa, 0x4c, 0x91, 0x43, 0x0e, 0xee, 0x40, 0x15, 0x15, 0x71, 0x0d, 0x74, 0x62, 0x33, 0x8b, 0x9d,
-	0x4f, 0xac, 0x2e, 0xda, 0xc2, 0x00, 0xda, 0xa9, 0xd5, 0x61, 0xd6, 0x00, 0x29, 0xe2, 0x7c, 0x6c,
-	0x9d, 0x9c, 0x10, 0x8a, 0x0a, 0xb8, 0x0a, 0x65, 0xd3, 0x71, 0x2c, 0x87, 0x11, 0x8a, 0x8a, 0xb8,
-	0x0c, 0x2a, 0x23, 0x67, 0x0c, 0xa9, 0xb8, 0x0e, 0x40, 0x4e, 0x89, 0xcd, 0x26, 0xb6, 0x39, 0x20,
-	0xa8, 0x24, 0x62, 0x3a, 0x63, 0x87, 0x0d, 0x07, 0x48, 0xc3, 0xff, 0x83, 0x5d, 0xd6, 0xa7, 0xc3,
-	0x37, 0x84, 0x4e, 0xd6, 0x25, 0xb6, 0x0f, 0xfe, 0x2c, 0xac, 0x4a, 0x97, 0x41, 0xfd, 0x76, 0x3c,
-	0x18, 0xa1, 0x2d, 0x71, 0x7a, 0x6d, 0x51, 0x82, 0x14, 0x71, 0xea, 0x8f, 0x29, 0x43, 0x05, 0x5c,
-	0x81, 0xed, 0xd7, 0x27, 0xa6, 0xd3, 0x27, 0xdd, 0xa4, 0xa0, 0x68, 0x05, 0xa9, 0x78, 0x17, 0x6a,
-	0x74, 0x38, 0xb6, 0xbb, 0x13, 0x87, 0x99, 0x94, 0x91, 0x2e, 0x2a, 0x89, 0x11, 0x9c, 0x37, 0xe6,
-	0x68, 0xc2, 0x88, 0x29, 0xca, 0xd6, 0x01, 0xba, 0x96, 0xd3, 0x19, 0xda, 0x36, 0xe9, 0x30, 0xb4,
-	0x8d, 0x11, 0x54, 0x3b, 0x7d, 0x93, 0x4d, 0x06, 0xc4, 0x71, 0xcc, 0x1e, 0x41, 0xe5, 0x5c, 0x93,
-	0xba, 0xc8, 0x37, 0x30, 0x59, 0xa7, 0x9f, 0xe5, 0x03, 0xbc, 0x0f, 0xb8, 0x67, 0x0e, 0xc8, 0x64,
-	0xd4, 0x37, 0x1d, 0x32, 0xe9, 0xf4, 0x4d, 0xbb, 0x47, 0xba, 0xa8, 0x22, 0xa8, 0xce, 0x60, 0x78,
-	0x4c, 0x32, 0x6a, 0x75, 0x0d, 0x91, 0xb3, 0x91, 0x45, 0x49, 0x17, 0xd5, 0x04, 0xd4, 0x25, 0x9d,
-	0xe1, 0x79, 0xc6, 0xaa, 0xaf, 0xa1, 0x94, 0xb5, 0x83, 0x0d, 0xd8, 0x13, 0x13, 0x4f, 0x7a, 0x94,
-	0xd8, 0x66, 0x77, 0x9d, 0x12, 0xfd, 0xc3, 0x93, 0xc6, 0xec, 0x0a, 0x4f, 0x7f, 0x03, 0x3f, 0x19,
-	0x3a, 0xd6, 0xd0, 0x46, 0x18, 0x3f, 0x83, 0x1d, 0xa9, 0x55, 0x0e, 0x7c, 0xf6, 0xf2, 0x18, 0x54,
-	0xf1, 0x59, 0x10, 0x7a, 0x8c, 0x6d, 0xb1, 0xbc, 0x9e, 0x4d, 0xc4, 0x8a, 0x6b, 0xa0, 0x33, 0x42,
-	0xe9, 0x90, 0x5a, 0x0e, 0x43, 0x8a, 0xd8, 0x52, 0x67, 0x38, 0xb6, 0x19, 0xa1, 0x93, 0x35, 0x5c,
-	0x90, 0xa2, 0x8e, 0x48, 0x87, 0x99, 0x6c, 0x48, 0x51, 0xf1, 0x95, 0xf1, 0xeb, 0x43, 0x53, 0x79,
-	0xff, 0xd0, 0x54, 0xfe, 0x78, 0x68, 0x2a, 0x3f, 0x3d, 0x36, 0xb7, 0xde, 0x3f, 0x36, 0xb7, 0x7e,
-	0x7f, 0x6c, 0x6e, 0x5d, 0x68, 0xf2, 0x2f, 0xff, 0xd5, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3,
-	0x51, 0xb6, 0x81, 0xf5, 0x07, 0x00, 0x00,
+func (m *Replay_Snapshot_EntityStats) Reset()         { *m = Replay_Snapshot_EntityStats{} }
+func (m *Replay_Snapshot_EntityStats) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_EntityStats) ProtoMessage()    {}
+func (*Replay_Snapshot_EntityStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 2}
+}
+func (m *Replay_Snapshot_EntityStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_EntityStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_EntityStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Replay_Snapshot_EntityStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_EntityStats.Merge(m, src)
+}
+func (m *Replay_Snapshot_EntityStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_EntityStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_EntityStats.DiscardUnknown(m)
 }
 
-func (m *Point) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Replay_Snapshot_EntityStats proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_EntityStats) GetKills() int32 {
+	if m != nil {
+		return m.Kills
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Point) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityStats) GetDeaths() int32 {
+	if m != nil {
+		return m.Deaths
+	}
+	return 0
 }
 
-func (m *Point) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Z != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Z))
-		i--
-		dAtA[i] = 0x18
+func (m *Replay_Snapshot_EntityStats) GetAssists() int32 {
+	if m != nil {
+		return m.Assists
 	}
-	if m.Y != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Y))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Replay_Snapshot_EntityStats) GetMvps() int32 {
+	if m != nil {
+		return m.Mvps
 	}
-	if m.X != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.X))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Replay_Snapshot_EntityStats) GetScore() int32 {
+	if m != nil {
+		return m.Score
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Replay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityStats) GetPing() int32 {
+	if m != nil {
+		return m.Ping
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Replay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Replay_Snapshot_EntityUpdate struct {
+	EntityId          int32                              `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Positions         []*Point                           `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	AngleX            int32                              `protobuf:"varint,3,opt,name=angleX,proto3" json:"angleX,omitempty"`
+	Hp                int32                              `protobuf:"varint,4,opt,name=hp,proto3" json:"hp,omitempty"`
+	Armor             int32                              `protobuf:"varint,5,opt,name=armor,proto3" json:"armor,omitempty"`
+	FlashDuration     float32                            `protobuf:"fixed32,6,opt,name=flashDuration,proto3" json:"flashDuration,omitempty"`
+	Team              Team                               `protobuf:"varint,7,opt,name=team,proto3,enum=gen.Team" json:"team,omitempty"`
+	IsNpc             bool                               `protobuf:"varint,8,opt,name=isNpc,proto3" json:"isNpc,omitempty"`
+	AngleY            int32                              `protobuf:"varint,9,opt,name=angleY,proto3" json:"angleY,omitempty"`
+	HasHelmet         bool                               `protobuf:"varint,10,opt,name=hasHelmet,proto3" json:"hasHelmet,omitempty"`
+	HasDefuseKit      bool                               `protobuf:"varint,11,opt,name=hasDefuseKit,proto3" json:"hasDefuseKit,omitempty"`
+	Equipment         []*Replay_Snapshot_EntityEquipment `protobuf:"bytes,12,rep,name=equipment,proto3" json:"equipment,omitempty"`
+	ChangedFields     uint32                             `protobuf:"varint,13,opt,name=changedFields,proto3" json:"changedFields,omitempty"`
+	Economy           *Replay_Snapshot_EntityEconomy     `protobuf:"bytes,14,opt,name=economy,proto3" json:"economy,omitempty"`
+	Stats             *Replay_Snapshot_EntityStats       `protobuf:"bytes,15,opt,name=stats,proto3" json:"stats,omitempty"`
+	Velocity          *Point                             `protobuf:"bytes,16,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	IsDucking         bool                               `protobuf:"varint,17,opt,name=isDucking,proto3" json:"isDucking,omitempty"`
+	IsWalking         bool                               `protobuf:"varint,18,opt,name=isWalking,proto3" json:"isWalking,omitempty"`
+	IsAirborne        bool                               `protobuf:"varint,19,opt,name=isAirborne,proto3" json:"isAirborne,omitempty"`
+	IsScoped          bool                               `protobuf:"varint,20,opt,name=isScoped,proto3" json:"isScoped,omitempty"`
+	IsDefusing        bool                               `protobuf:"varint,21,opt,name=isDefusing,proto3" json:"isDefusing,omitempty"`
+	IsPlanting        bool                               `protobuf:"varint,22,opt,name=isPlanting,proto3" json:"isPlanting,omitempty"`
+	IsReloading       bool                               `protobuf:"varint,23,opt,name=isReloading,proto3" json:"isReloading,omitempty"`
+	ActiveWeapon      int32                              `protobuf:"varint,24,opt,name=activeWeapon,proto3" json:"activeWeapon,omitempty"`
+	ActiveWeaponIndex int32                              `protobuf:"varint,25,opt,name=activeWeaponIndex,proto3" json:"activeWeaponIndex,omitempty"`
 }
 
-func (m *Replay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ticks) > 0 {
-		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Snapshots) > 0 {
-		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Entities) > 0 {
-		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintReplay(dAtA, i, uint64(size))
+func (m *Replay_Snapshot_EntityUpdate) Reset()         { *m = Replay_Snapshot_EntityUpdate{} }
+func (m *Replay_Snapshot_EntityUpdate) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_EntityUpdate) ProtoMessage()    {}
+func (*Replay_Snapshot_EntityUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 3}
+}
+func (m *Replay_Snapshot_EntityUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_EntityUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_EntityUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Replay_Snapshot_EntityUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_EntityUpdate.Merge(m, src)
+}
+func (m *Replay_Snapshot_EntityUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_EntityUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_EntityUpdate.DiscardUnknown(m)
 }
 
-func (m *Replay_Header) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Replay_Snapshot_EntityUpdate proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_EntityUpdate) GetEntityId() int32 {
+	if m != nil {
+		return m.EntityId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Replay_Header) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetPositions() []*Point {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
 }
 
-func (m *Replay_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SnapshotRate != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.SnapshotRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TickRate != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TickRate))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if len(m.Map) > 0 {
-		i -= len(m.Map)
-		copy(dAtA[i:], m.Map)
-		i = encodeVarintReplay(dAtA, i, uint64(len(m.Map)))
-		i--
-		dAtA[i] = 0xa
+func (m *Replay_Snapshot_EntityUpdate) GetAngleX() int32 {
+	if m != nil {
+		return m.AngleX
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Replay_Entity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetHp() int32 {
+	if m != nil {
+		return m.Hp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Replay_Entity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetArmor() int32 {
+	if m != nil {
+		return m.Armor
+	}
+	return 0
 }
 
-func (m *Replay_Entity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsNpc {
-		i--
-		if m.IsNpc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Team != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Team))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintReplay(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+func (m *Replay_Snapshot_EntityUpdate) GetFlashDuration() float32 {
+	if m != nil {
+		return m.FlashDuration
 	}
-	if m.Id != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetTeam() Team {
+	if m != nil {
+		return m.Team
 	}
-	return len(dAtA) - i, nil
+	return Team_UNASSIGNED
 }
 
-func (m *Replay_Snapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetIsNpc() bool {
+	if m != nil {
+		return m.IsNpc
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Replay_Snapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetAngleY() int32 {
+	if m != nil {
+		return m.AngleY
+	}
+	return 0
 }
 
-func (m *Replay_Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EntityUpdates) > 0 {
-		for iNdEx := len(m.EntityUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EntityUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Replay_Snapshot_EntityUpdate) GetHasHelmet() bool {
+	if m != nil {
+		return m.HasHelmet
 	}
-	if m.Tick != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Tick))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetHasDefuseKit() bool {
+	if m != nil {
+		return m.HasDefuseKit
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Replay_Snapshot_EntityEquipment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetEquipment() []*Replay_Snapshot_EntityEquipment {
+	if m != nil {
+		return m.Equipment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Replay_Snapshot_EntityEquipment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetChangedFields() uint32 {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return 0
 }
 
-func (m *Replay_Snapshot_EntityEquipment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AmmoInMagazine != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.AmmoInMagazine))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AmmoReserve != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.AmmoReserve))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+func (m *Replay_Snapshot_EntityUpdate) GetEconomy() *Replay_Snapshot_EntityEconomy {
+	if m != nil {
+		return m.Economy
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Replay_Snapshot_EntityUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetStats() *Replay_Snapshot_EntityStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Replay_Snapshot_EntityUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetVelocity() *Point {
+	if m != nil {
+		return m.Velocity
+	}
+	return nil
 }
 
-func (m *Replay_Snapshot_EntityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Equipment) > 0 {
-		for iNdEx := len(m.Equipment) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Equipment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if m.HasDefuseKit {
-		i--
-		if m.HasDefuseKit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.HasHelmet {
-		i--
-		if m.HasHelmet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.AngleY != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.AngleY))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IsNpc {
-		i--
-		if m.IsNpc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Team != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Team))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.FlashDuration != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FlashDuration))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.Armor != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Armor))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Hp != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Hp))
-		i--
-		dAtA[i] = 0x20
+func (m *Replay_Snapshot_EntityUpdate) GetIsDucking() bool {
+	if m != nil {
+		return m.IsDucking
 	}
-	if m.AngleX != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.AngleX))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetIsWalking() bool {
+	if m != nil {
+		return m.IsWalking
 	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return false
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetIsAirborne() bool {
+	if m != nil {
+		return m.IsAirborne
 	}
-	if m.EntityId != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.EntityId))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetIsScoped() bool {
+	if m != nil {
+		return m.IsScoped
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Replay_Tick) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetIsDefusing() bool {
+	if m != nil {
+		return m.IsDefusing
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Replay_Tick) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_EntityUpdate) GetIsPlanting() bool {
+	if m != nil {
+		return m.IsPlanting
+	}
+	return false
 }
 
-func (m *Replay_Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Replay_Snapshot_EntityUpdate) GetIsReloading() bool {
+	if m != nil {
+		return m.IsReloading
 	}
-	if m.Nr != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Nr))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *Replay_Snapshot_EntityUpdate) GetActiveWeapon() int32 {
+	if m != nil {
+		return m.ActiveWeapon
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Replay_Tick_Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Replay_Snapshot_EntityUpdate) GetActiveWeaponIndex() int32 {
+	if m != nil {
+		return m.ActiveWeaponIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Replay_Tick_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Replay_Snapshot_Bomb struct {
+	CarrierId       int32   `protobuf:"varint,1,opt,name=carrierId,proto3" json:"carrierId,omitempty"`
+	Position        *Point  `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	IsPlanted       bool    `protobuf:"varint,3,opt,name=isPlanted,proto3" json:"isPlanted,omitempty"`
+	Site            string  `protobuf:"bytes,4,opt,name=site,proto3" json:"site,omitempty"`
+	TimeToExplosion float32 `protobuf:"fixed32,5,opt,name=timeToExplosion,proto3" json:"timeToExplosion,omitempty"`
+	DefuserId       int32   `protobuf:"varint,6,opt,name=defuserId,proto3" json:"defuserId,omitempty"`
+	DefuseProgress  float32 `protobuf:"fixed32,7,opt,name=defuseProgress,proto3" json:"defuseProgress,omitempty"`
 }
 
-func (m *Replay_Tick_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintReplay(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *Replay_Snapshot_Bomb) Reset()         { *m = Replay_Snapshot_Bomb{} }
+func (m *Replay_Snapshot_Bomb) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_Bomb) ProtoMessage()    {}
+func (*Replay_Snapshot_Bomb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 4}
+}
+func (m *Replay_Snapshot_Bomb) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_Bomb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_Bomb.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Kind != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Replay_Snapshot_Bomb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_Bomb.Merge(m, src)
+}
+func (m *Replay_Snapshot_Bomb) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_Bomb) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_Bomb.DiscardUnknown(m)
 }
 
-func (m *Replay_Tick_Event_Attribute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Replay_Snapshot_Bomb proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_Bomb) GetCarrierId() int32 {
+	if m != nil {
+		return m.CarrierId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Replay_Tick_Event_Attribute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Replay_Snapshot_Bomb) GetPosition() *Point {
+	if m != nil {
+		return m.Position
+	}
+	return nil
 }
 
-func (m *Replay_Tick_Event_Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CustomName) > 0 {
-		i -= len(m.CustomName)
-		copy(dAtA[i:], m.CustomName)
-		i = encodeVarintReplay(dAtA, i, uint64(len(m.CustomName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NumberValue != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NumberValue))))
-		i--
-		dAtA[i] = 0x19
-	}
-	if len(m.StringValue) > 0 {
-		i -= len(m.StringValue)
-		copy(dAtA[i:], m.StringValue)
-		i = encodeVarintReplay(dAtA, i, uint64(len(m.StringValue)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Kind != 0 {
-		i = encodeVarintReplay(dAtA, i, uint64(m.Kind))
-		i--
-		dAtA[i] = 0x8
+func (m *Replay_Snapshot_Bomb) GetIsPlanted() bool {
+	if m != nil {
+		return m.IsPlanted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintReplay(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReplay(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Replay_Snapshot_Bomb) GetSite() string {
+	if m != nil {
+		return m.Site
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Point) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.X != 0 {
-		n += 1 + sovReplay(uint64(m.X))
-	}
-	if m.Y != 0 {
-		n += 1 + sovReplay(uint64(m.Y))
-	}
-	if m.Z != 0 {
-		n += 1 + sovReplay(uint64(m.Z))
+
+func (m *Replay_Snapshot_Bomb) GetTimeToExplosion() float32 {
+	if m != nil {
+		return m.TimeToExplosion
 	}
-	return n
+	return 0
 }
 
-func (m *Replay) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovReplay(uint64(l))
-	}
-	if len(m.Entities) > 0 {
-		for _, e := range m.Entities {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
-		}
+func (m *Replay_Snapshot_Bomb) GetDefuserId() int32 {
+	if m != nil {
+		return m.DefuserId
 	}
-	if len(m.Snapshots) > 0 {
-		for _, e := range m.Snapshots {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
-		}
+	return 0
+}
+
+func (m *Replay_Snapshot_Bomb) GetDefuseProgress() float32 {
+	if m != nil {
+		return m.DefuseProgress
 	}
-	if len(m.Ticks) > 0 {
-		for _, e := range m.Ticks {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
+	return 0
+}
+
+type Replay_Snapshot_Inferno struct {
+	Id        int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThrowerId int32    `protobuf:"varint,2,opt,name=throwerId,proto3" json:"throwerId,omitempty"`
+	Fires     []*Point `protobuf:"bytes,3,rep,name=fires,proto3" json:"fires,omitempty"`
+}
+
+func (m *Replay_Snapshot_Inferno) Reset()         { *m = Replay_Snapshot_Inferno{} }
+func (m *Replay_Snapshot_Inferno) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_Inferno) ProtoMessage()    {}
+func (*Replay_Snapshot_Inferno) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 5}
+}
+func (m *Replay_Snapshot_Inferno) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_Inferno) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_Inferno.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Snapshot_Inferno) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_Inferno.Merge(m, src)
+}
+func (m *Replay_Snapshot_Inferno) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_Inferno) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_Inferno.DiscardUnknown(m)
 }
 
-func (m *Replay_Header) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Map)
-	if l > 0 {
-		n += 1 + l + sovReplay(uint64(l))
-	}
-	if m.TickRate != 0 {
-		n += 9
-	}
-	if m.SnapshotRate != 0 {
-		n += 1 + sovReplay(uint64(m.SnapshotRate))
+var xxx_messageInfo_Replay_Snapshot_Inferno proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_Inferno) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *Replay_Entity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovReplay(uint64(m.Id))
+func (m *Replay_Snapshot_Inferno) GetThrowerId() int32 {
+	if m != nil {
+		return m.ThrowerId
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovReplay(uint64(l))
+	return 0
+}
+
+func (m *Replay_Snapshot_Inferno) GetFires() []*Point {
+	if m != nil {
+		return m.Fires
 	}
-	if m.Team != 0 {
-		n += 1 + sovReplay(uint64(m.Team))
+	return nil
+}
+
+type Replay_Snapshot_Item struct {
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Position *Point `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *Replay_Snapshot_Item) Reset()         { *m = Replay_Snapshot_Item{} }
+func (m *Replay_Snapshot_Item) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_Item) ProtoMessage()    {}
+func (*Replay_Snapshot_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 6}
+}
+func (m *Replay_Snapshot_Item) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_Item.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.IsNpc {
-		n += 2
+}
+func (m *Replay_Snapshot_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_Item.Merge(m, src)
+}
+func (m *Replay_Snapshot_Item) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replay_Snapshot_Item proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_Item) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *Replay_Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Replay_Snapshot_Item) GetType() int32 {
+	if m != nil {
+		return m.Type
 	}
-	var l int
-	_ = l
-	if m.Tick != 0 {
-		n += 1 + sovReplay(uint64(m.Tick))
+	return 0
+}
+
+func (m *Replay_Snapshot_Item) GetPosition() *Point {
+	if m != nil {
+		return m.Position
 	}
-	if len(m.EntityUpdates) > 0 {
-		for _, e := range m.EntityUpdates {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
+	return nil
+}
+
+type Replay_Snapshot_Hostage struct {
+	Id        int32                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position  *Point                        `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	State     Replay_Snapshot_Hostage_State `protobuf:"varint,3,opt,name=state,proto3,enum=gen.Replay_Snapshot_Hostage_State" json:"state,omitempty"`
+	CarrierId int32                         `protobuf:"varint,4,opt,name=carrierId,proto3" json:"carrierId,omitempty"`
+	Hp        int32                         `protobuf:"varint,5,opt,name=hp,proto3" json:"hp,omitempty"`
+}
+
+func (m *Replay_Snapshot_Hostage) Reset()         { *m = Replay_Snapshot_Hostage{} }
+func (m *Replay_Snapshot_Hostage) String() string { return proto.CompactTextString(m) }
+func (*Replay_Snapshot_Hostage) ProtoMessage()    {}
+func (*Replay_Snapshot_Hostage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 3, 7}
+}
+func (m *Replay_Snapshot_Hostage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Snapshot_Hostage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Snapshot_Hostage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Snapshot_Hostage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Snapshot_Hostage.Merge(m, src)
+}
+func (m *Replay_Snapshot_Hostage) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Snapshot_Hostage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Snapshot_Hostage.DiscardUnknown(m)
 }
 
-func (m *Replay_Snapshot_EntityEquipment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovReplay(uint64(m.Type))
+var xxx_messageInfo_Replay_Snapshot_Hostage proto.InternalMessageInfo
+
+func (m *Replay_Snapshot_Hostage) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	if m.AmmoReserve != 0 {
-		n += 1 + sovReplay(uint64(m.AmmoReserve))
+	return 0
+}
+
+func (m *Replay_Snapshot_Hostage) GetPosition() *Point {
+	if m != nil {
+		return m.Position
 	}
-	if m.AmmoInMagazine != 0 {
-		n += 1 + sovReplay(uint64(m.AmmoInMagazine))
+	return nil
+}
+
+func (m *Replay_Snapshot_Hostage) GetState() Replay_Snapshot_Hostage_State {
+	if m != nil {
+		return m.State
 	}
-	return n
+	return Replay_Snapshot_Hostage_IDLE
 }
 
-func (m *Replay_Snapshot_EntityUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Replay_Snapshot_Hostage) GetCarrierId() int32 {
+	if m != nil {
+		return m.CarrierId
 	}
-	var l int
-	_ = l
-	if m.EntityId != 0 {
-		n += 1 + sovReplay(uint64(m.EntityId))
+	return 0
+}
+
+func (m *Replay_Snapshot_Hostage) GetHp() int32 {
+	if m != nil {
+		return m.Hp
 	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
-		}
-	}
-	if m.AngleX != 0 {
-		n += 1 + sovReplay(uint64(m.AngleX))
-	}
-	if m.Hp != 0 {
-		n += 1 + sovReplay(uint64(m.Hp))
-	}
-	if m.Armor != 0 {
-		n += 1 + sovReplay(uint64(m.Armor))
-	}
-	if m.FlashDuration != 0 {
-		n += 5
-	}
-	if m.Team != 0 {
-		n += 1 + sovReplay(uint64(m.Team))
-	}
-	if m.IsNpc {
-		n += 2
-	}
-	if m.AngleY != 0 {
-		n += 1 + sovReplay(uint64(m.AngleY))
-	}
-	if m.HasHelmet {
-		n += 2
-	}
-	if m.HasDefuseKit {
-		n += 2
-	}
-	if len(m.Equipment) > 0 {
-		for _, e := range m.Equipment {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
+	return 0
+}
+
+type Replay_Tick struct {
+	Nr     int32                `protobuf:"varint,1,opt,name=nr,proto3" json:"nr,omitempty"`
+	Events []*Replay_Tick_Event `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *Replay_Tick) Reset()         { *m = Replay_Tick{} }
+func (m *Replay_Tick) String() string { return proto.CompactTextString(m) }
+func (*Replay_Tick) ProtoMessage()    {}
+func (*Replay_Tick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 4}
+}
+func (m *Replay_Tick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Tick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Tick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Tick.Merge(m, src)
+}
+func (m *Replay_Tick) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Tick) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Tick.DiscardUnknown(m)
 }
 
-func (m *Replay_Tick) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_Replay_Tick proto.InternalMessageInfo
+
+func (m *Replay_Tick) GetNr() int32 {
+	if m != nil {
+		return m.Nr
 	}
-	var l int
-	_ = l
-	if m.Nr != 0 {
-		n += 1 + sovReplay(uint64(m.Nr))
+	return 0
+}
+
+func (m *Replay_Tick) GetEvents() []*Replay_Tick_Event {
+	if m != nil {
+		return m.Events
 	}
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
+	return nil
+}
+
+type Replay_Tick_Event struct {
+	Kind       Replay_Tick_Event_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=gen.Replay_Tick_Event_Kind" json:"kind,omitempty"`
+	Attributes []*Replay_Tick_Event_Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *Replay_Tick_Event) Reset()         { *m = Replay_Tick_Event{} }
+func (m *Replay_Tick_Event) String() string { return proto.CompactTextString(m) }
+func (*Replay_Tick_Event) ProtoMessage()    {}
+func (*Replay_Tick_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 4, 0}
+}
+func (m *Replay_Tick_Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Tick_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Tick_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Tick_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Tick_Event.Merge(m, src)
+}
+func (m *Replay_Tick_Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Tick_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Tick_Event.DiscardUnknown(m)
 }
 
-func (m *Replay_Tick_Event) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_Replay_Tick_Event proto.InternalMessageInfo
+
+func (m *Replay_Tick_Event) GetKind() Replay_Tick_Event_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	var l int
-	_ = l
-	if m.Kind != 0 {
-		n += 1 + sovReplay(uint64(m.Kind))
+	return Replay_Tick_Event_JUMP
+}
+
+func (m *Replay_Tick_Event) GetAttributes() []*Replay_Tick_Event_Attribute {
+	if m != nil {
+		return m.Attributes
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovReplay(uint64(l))
+	return nil
+}
+
+type Replay_Tick_Event_Attribute struct {
+	Kind        Replay_Tick_Event_Attribute_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=gen.Replay_Tick_Event_Attribute_Kind" json:"kind,omitempty"`
+	StringValue string                           `protobuf:"bytes,2,opt,name=stringValue,proto3" json:"stringValue,omitempty"`
+	NumberValue float64                          `protobuf:"fixed64,3,opt,name=numberValue,proto3" json:"numberValue,omitempty"`
+	CustomName  string                           `protobuf:"bytes,4,opt,name=customName,proto3" json:"customName,omitempty"`
+}
+
+func (m *Replay_Tick_Event_Attribute) Reset()         { *m = Replay_Tick_Event_Attribute{} }
+func (m *Replay_Tick_Event_Attribute) String() string { return proto.CompactTextString(m) }
+func (*Replay_Tick_Event_Attribute) ProtoMessage()    {}
+func (*Replay_Tick_Event_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 4, 0, 0}
+}
+func (m *Replay_Tick_Event_Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Tick_Event_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Tick_Event_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Tick_Event_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Tick_Event_Attribute.Merge(m, src)
+}
+func (m *Replay_Tick_Event_Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Tick_Event_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Tick_Event_Attribute.DiscardUnknown(m)
 }
 
-func (m *Replay_Tick_Event_Attribute) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_Replay_Tick_Event_Attribute proto.InternalMessageInfo
+
+func (m *Replay_Tick_Event_Attribute) GetKind() Replay_Tick_Event_Attribute_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	var l int
-	_ = l
-	if m.Kind != 0 {
-		n += 1 + sovReplay(uint64(m.Kind))
+	return Replay_Tick_Event_Attribute_ENTITY_ID
+}
+
+func (m *Replay_Tick_Event_Attribute) GetStringValue() string {
+	if m != nil {
+		return m.StringValue
 	}
-	l = len(m.StringValue)
-	if l > 0 {
-		n += 1 + l + sovReplay(uint64(l))
+	return ""
+}
+
+func (m *Replay_Tick_Event_Attribute) GetNumberValue() float64 {
+	if m != nil {
+		return m.NumberValue
 	}
-	if m.NumberValue != 0 {
-		n += 9
+	return 0
+}
+
+func (m *Replay_Tick_Event_Attribute) GetCustomName() string {
+	if m != nil {
+		return m.CustomName
 	}
-	l = len(m.CustomName)
-	if l > 0 {
-		n += 1 + l + sovReplay(uint64(l))
+	return ""
+}
+
+type Replay_Warning struct {
+	Tick    int32  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Type    int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *Replay_Warning) Reset()         { *m = Replay_Warning{} }
+func (m *Replay_Warning) String() string { return proto.CompactTextString(m) }
+func (*Replay_Warning) ProtoMessage()    {}
+func (*Replay_Warning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 5}
+}
+func (m *Replay_Warning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Warning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Warning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Replay_Warning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Warning.Merge(m, src)
+}
+func (m *Replay_Warning) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Warning) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Warning.DiscardUnknown(m)
 }
 
-func sovReplay(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_Replay_Warning proto.InternalMessageInfo
+
+func (m *Replay_Warning) GetTick() int32 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
 }
-func sozReplay(x uint64) (n int) {
-	return sovReplay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Replay_Warning) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Replay_Warning) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type Replay_Round struct {
+	Nr                     int32                    `protobuf:"varint,1,opt,name=nr,proto3" json:"nr,omitempty"`
+	StartTick              int32                    `protobuf:"varint,2,opt,name=startTick,proto3" json:"startTick,omitempty"`
+	FreezeTimeEndTick      int32                    `protobuf:"varint,3,opt,name=freezeTimeEndTick,proto3" json:"freezeTimeEndTick,omitempty"`
+	EndTick                int32                    `protobuf:"varint,4,opt,name=endTick,proto3" json:"endTick,omitempty"`
+	Winner                 Team                     `protobuf:"varint,5,opt,name=winner,proto3,enum=gen.Team" json:"winner,omitempty"`
+	Reason                 int32                    `protobuf:"varint,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ScoreTerrorists        int32                    `protobuf:"varint,7,opt,name=scoreTerrorists,proto3" json:"scoreTerrorists,omitempty"`
+	ScoreCounterTerrorists int32                    `protobuf:"varint,8,opt,name=scoreCounterTerrorists,proto3" json:"scoreCounterTerrorists,omitempty"`
+	BombOutcome            Replay_Round_BombOutcome `protobuf:"varint,9,opt,name=bombOutcome,proto3,enum=gen.Replay_Round_BombOutcome" json:"bombOutcome,omitempty"`
+}
+
+func (m *Replay_Round) Reset()         { *m = Replay_Round{} }
+func (m *Replay_Round) String() string { return proto.CompactTextString(m) }
+func (*Replay_Round) ProtoMessage()    {}
+func (*Replay_Round) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 6}
+}
+func (m *Replay_Round) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Round) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Round.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Replay_Round) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Round.Merge(m, src)
+}
+func (m *Replay_Round) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Round) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Round.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replay_Round proto.InternalMessageInfo
+
+func (m *Replay_Round) GetNr() int32 {
+	if m != nil {
+		return m.Nr
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetStartTick() int32 {
+	if m != nil {
+		return m.StartTick
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetFreezeTimeEndTick() int32 {
+	if m != nil {
+		return m.FreezeTimeEndTick
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetEndTick() int32 {
+	if m != nil {
+		return m.EndTick
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetWinner() Team {
+	if m != nil {
+		return m.Winner
+	}
+	return Team_UNASSIGNED
+}
+
+func (m *Replay_Round) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetScoreTerrorists() int32 {
+	if m != nil {
+		return m.ScoreTerrorists
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetScoreCounterTerrorists() int32 {
+	if m != nil {
+		return m.ScoreCounterTerrorists
+	}
+	return 0
+}
+
+func (m *Replay_Round) GetBombOutcome() Replay_Round_BombOutcome {
+	if m != nil {
+		return m.BombOutcome
+	}
+	return Replay_Round_NONE
+}
+
+type Replay_Projectile struct {
+	Id             int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThrowerId      int32    `protobuf:"varint,2,opt,name=throwerId,proto3" json:"throwerId,omitempty"`
+	Type           int32    `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	ThrowTick      int32    `protobuf:"varint,4,opt,name=throwTick,proto3" json:"throwTick,omitempty"`
+	DetonationTick int32    `protobuf:"varint,5,opt,name=detonationTick,proto3" json:"detonationTick,omitempty"`
+	Trajectory     []*Point `protobuf:"bytes,6,rep,name=trajectory,proto3" json:"trajectory,omitempty"`
+}
+
+func (m *Replay_Projectile) Reset()         { *m = Replay_Projectile{} }
+func (m *Replay_Projectile) String() string { return proto.CompactTextString(m) }
+func (*Replay_Projectile) ProtoMessage()    {}
+func (*Replay_Projectile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed9461330ccfc03, []int{1, 7}
+}
+func (m *Replay_Projectile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Replay_Projectile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Replay_Projectile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Replay_Projectile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Replay_Projectile.Merge(m, src)
+}
+func (m *Replay_Projectile) XXX_Size() int {
+	return m.Size()
+}
+func (m *Replay_Projectile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Replay_Projectile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Replay_Projectile proto.InternalMessageInfo
+
+func (m *Replay_Projectile) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Replay_Projectile) GetThrowerId() int32 {
+	if m != nil {
+		return m.ThrowerId
+	}
+	return 0
+}
+
+func (m *Replay_Projectile) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Replay_Projectile) GetThrowTick() int32 {
+	if m != nil {
+		return m.ThrowTick
+	}
+	return 0
+}
+
+func (m *Replay_Projectile) GetDetonationTick() int32 {
+	if m != nil {
+		return m.DetonationTick
+	}
+	return 0
+}
+
+func (m *Replay_Projectile) GetTrajectory() []*Point {
+	if m != nil {
+		return m.Trajectory
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gen.Team", Team_name, Team_value)
+	proto.RegisterEnum("gen.Replay_Snapshot_Hostage_State", Replay_Snapshot_Hostage_State_name, Replay_Snapshot_Hostage_State_value)
+	proto.RegisterEnum("gen.Replay_Tick_Event_Kind", Replay_Tick_Event_Kind_name, Replay_Tick_Event_Kind_value)
+	proto.RegisterEnum("gen.Replay_Tick_Event_Attribute_Kind", Replay_Tick_Event_Attribute_Kind_name, Replay_Tick_Event_Attribute_Kind_value)
+	proto.RegisterEnum("gen.Replay_Round_BombOutcome", Replay_Round_BombOutcome_name, Replay_Round_BombOutcome_value)
+	proto.RegisterType((*Point)(nil), "gen.Point")
+	proto.RegisterType((*Replay)(nil), "gen.Replay")
+	proto.RegisterType((*Replay_Header)(nil), "gen.Replay.Header")
+	proto.RegisterType((*Replay_Footer)(nil), "gen.Replay.Footer")
+	proto.RegisterType((*Replay_Footer_Team)(nil), "gen.Replay.Footer.Team")
+	proto.RegisterType((*Replay_Footer_Score)(nil), "gen.Replay.Footer.Score")
+	proto.RegisterType((*Replay_Entity)(nil), "gen.Replay.Entity")
+	proto.RegisterType((*Replay_Snapshot)(nil), "gen.Replay.Snapshot")
+	proto.RegisterType((*Replay_Snapshot_EntityEquipment)(nil), "gen.Replay.Snapshot.EntityEquipment")
+	proto.RegisterType((*Replay_Snapshot_EntityEconomy)(nil), "gen.Replay.Snapshot.EntityEconomy")
+	proto.RegisterType((*Replay_Snapshot_EntityStats)(nil), "gen.Replay.Snapshot.EntityStats")
+	proto.RegisterType((*Replay_Snapshot_EntityUpdate)(nil), "gen.Replay.Snapshot.EntityUpdate")
+	proto.RegisterType((*Replay_Snapshot_Bomb)(nil), "gen.Replay.Snapshot.Bomb")
+	proto.RegisterType((*Replay_Snapshot_Inferno)(nil), "gen.Replay.Snapshot.Inferno")
+	proto.RegisterType((*Replay_Snapshot_Item)(nil), "gen.Replay.Snapshot.Item")
+	proto.RegisterType((*Replay_Snapshot_Hostage)(nil), "gen.Replay.Snapshot.Hostage")
+	proto.RegisterType((*Replay_Tick)(nil), "gen.Replay.Tick")
+	proto.RegisterType((*Replay_Tick_Event)(nil), "gen.Replay.Tick.Event")
+	proto.RegisterType((*Replay_Tick_Event_Attribute)(nil), "gen.Replay.Tick.Event.Attribute")
+	proto.RegisterType((*Replay_Warning)(nil), "gen.Replay.Warning")
+	proto.RegisterType((*Replay_Round)(nil), "gen.Replay.Round")
+	proto.RegisterType((*Replay_Projectile)(nil), "gen.Replay.Projectile")
+}
+
+func init() { proto.RegisterFile("replay.proto", fileDescriptor_eed9461330ccfc03) }
+
+var fileDescriptor_eed9461330ccfc03 = []byte{
+	// 2813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0xcd, 0x6f, 0x23, 0xc7,
+	0xb1, 0x5f, 0x7e, 0x0c, 0x3f, 0x8a, 0xfa, 0x68, 0xb5, 0x64, 0xed, 0x2c, 0xbd, 0x96, 0x65, 0x3d,
+	0x3f, 0x63, 0xdf, 0xe2, 0x59, 0xf6, 0xd3, 0x4b, 0x16, 0x0e, 0x10, 0xc0, 0x19, 0x91, 0xbd, 0xe2,
+	0xac, 0x24, 0x92, 0xe8, 0x19, 0xad, 0xbc, 0x27, 0x62, 0x44, 0xb6, 0xa4, 0xb1, 0xc8, 0x19, 0x66,
+	0x66, 0xb4, 0xbb, 0xda, 0x4b, 0x80, 0x00, 0x39, 0x27, 0x40, 0xae, 0x01, 0x72, 0xc8, 0xdf, 0x90,
+	0x53, 0x2e, 0x39, 0xfa, 0x14, 0xf8, 0x18, 0x20, 0x97, 0xc0, 0x3e, 0xe5, 0x92, 0x53, 0x6e, 0xb9,
+	0x04, 0x55, 0x3d, 0x43, 0x8e, 0x3e, 0xbc, 0x4e, 0x6e, 0xac, 0x5f, 0xfd, 0xaa, 0xbb, 0xba, 0xeb,
+	0xa3, 0xbb, 0x87, 0xb0, 0x10, 0xa9, 0xe9, 0xd8, 0xbb, 0xda, 0x9e, 0x46, 0x61, 0x12, 0xf2, 0xd2,
+	0x99, 0x0a, 0xb6, 0xfe, 0x0f, 0x8c, 0x7e, 0xe8, 0x07, 0x09, 0x5f, 0x80, 0xc2, 0x6b, 0xb3, 0xb0,
+	0x59, 0x78, 0x64, 0xc8, 0xc2, 0x6b, 0x94, 0xae, 0xcc, 0xa2, 0x96, 0xae, 0x50, 0x7a, 0x63, 0x96,
+	0xb4, 0xf4, 0x66, 0xeb, 0xab, 0x4f, 0xa1, 0x22, 0x69, 0x20, 0xfe, 0x18, 0x2a, 0xe7, 0xca, 0x1b,
+	0xa9, 0x88, 0x2c, 0x1b, 0x3b, 0x7c, 0xfb, 0x4c, 0x05, 0xdb, 0x5a, 0xb9, 0xdd, 0x21, 0x8d, 0x4c,
+	0x19, 0x7c, 0x1b, 0x6a, 0x2a, 0x48, 0xfc, 0xc4, 0x57, 0xb1, 0x59, 0xdc, 0x2c, 0xdd, 0x64, 0x0b,
+	0xd4, 0x5d, 0xc9, 0x19, 0x87, 0xef, 0x40, 0x3d, 0x0e, 0xbc, 0x69, 0x7c, 0x1e, 0x26, 0xb1, 0x59,
+	0x22, 0x83, 0xb5, 0xbc, 0x81, 0x93, 0x2a, 0xe5, 0x9c, 0xc6, 0x3f, 0x02, 0x23, 0xf1, 0x87, 0x17,
+	0xb1, 0x59, 0x26, 0x3e, 0xcb, 0xf3, 0x5d, 0x7f, 0x78, 0x21, 0xb5, 0x9a, 0x7f, 0x02, 0xb5, 0x57,
+	0x5e, 0x14, 0xf8, 0xc1, 0x59, 0x6c, 0x1a, 0x44, 0x5d, 0xcd, 0x53, 0x8f, 0xb5, 0x4e, 0xce, 0x48,
+	0xfc, 0x7f, 0xa0, 0x12, 0x85, 0x97, 0xc1, 0x28, 0x36, 0x2b, 0x44, 0x5f, 0xc9, 0xd3, 0x25, 0x6a,
+	0x64, 0x4a, 0xe0, 0x9f, 0x41, 0x63, 0x1a, 0x85, 0x5f, 0xaa, 0x61, 0xe2, 0x8f, 0x55, 0x6c, 0x56,
+	0x89, 0xbf, 0x9e, 0xe7, 0xf7, 0x67, 0x6a, 0x99, 0xa7, 0xe2, 0x6e, 0x9e, 0x86, 0x61, 0xa2, 0x22,
+	0xb3, 0x76, 0x7b, 0x37, 0x9f, 0x92, 0x46, 0xa6, 0x8c, 0xe6, 0x3f, 0x4a, 0x50, 0xd1, 0x1b, 0xcc,
+	0x19, 0x94, 0x26, 0xde, 0x94, 0x22, 0x50, 0x97, 0xf8, 0x93, 0x37, 0xa1, 0x86, 0xeb, 0x94, 0x5e,
+	0xa2, 0x28, 0x88, 0x05, 0x39, 0x93, 0xf9, 0x16, 0x2c, 0x64, 0xfb, 0x45, 0x7a, 0x1d, 0xd6, 0x6b,
+	0x18, 0xdf, 0x06, 0x3e, 0x0d, 0x63, 0x3f, 0xf1, 0xc3, 0xc0, 0xf1, 0x26, 0xd3, 0xb1, 0x22, 0x66,
+	0x99, 0x98, 0x77, 0x68, 0xf8, 0x0e, 0xac, 0xcd, 0xd7, 0x91, 0xb3, 0x60, 0x64, 0x71, 0xa7, 0x8e,
+	0x6f, 0x00, 0xc4, 0x2a, 0x7a, 0xa9, 0xa2, 0xae, 0x37, 0x51, 0x66, 0x95, 0x9c, 0xcf, 0x21, 0xa8,
+	0x1f, 0x8e, 0x7d, 0x15, 0x24, 0xa4, 0xaf, 0x69, 0xfd, 0x1c, 0xc1, 0x75, 0xe0, 0xbe, 0x9c, 0x78,
+	0xc3, 0x0b, 0xd7, 0x9f, 0x28, 0xb3, 0x4e, 0xeb, 0xbc, 0x86, 0xf1, 0x0f, 0x61, 0x71, 0x2e, 0x63,
+	0x5a, 0x00, 0x39, 0x74, 0x1d, 0xe4, 0x1f, 0xc1, 0x52, 0x06, 0x3c, 0x8d, 0xbc, 0x89, 0x8a, 0xcd,
+	0x06, 0xd1, 0x6e, 0xa0, 0xfc, 0x11, 0x2c, 0x07, 0x2a, 0x79, 0x15, 0x46, 0x17, 0x7d, 0xac, 0x9f,
+	0x61, 0x38, 0x36, 0x17, 0x88, 0x78, 0x13, 0xe6, 0x0f, 0xa1, 0x7e, 0x8a, 0x11, 0x4d, 0xbc, 0xc9,
+	0xd4, 0x5c, 0x24, 0xd7, 0xe7, 0x00, 0x8e, 0x33, 0xf1, 0x03, 0xff, 0xd4, 0x57, 0xd1, 0x73, 0x15,
+	0xc5, 0x7e, 0x18, 0x98, 0xcb, 0xc4, 0xb9, 0x09, 0x3f, 0x2b, 0xd7, 0x0c, 0x56, 0x79, 0x56, 0xae,
+	0x55, 0x58, 0xf5, 0x59, 0xb9, 0xb6, 0xc4, 0x96, 0x9b, 0x7f, 0x29, 0x42, 0x45, 0x67, 0x02, 0xff,
+	0x18, 0x8c, 0x44, 0x79, 0x93, 0xd8, 0x2c, 0x50, 0x86, 0xdd, 0xbf, 0x9d, 0x2c, 0xdb, 0xae, 0xf2,
+	0x26, 0x52, 0xb3, 0xf8, 0xa7, 0x50, 0x89, 0x87, 0x61, 0x34, 0x2b, 0x3e, 0xf3, 0x0e, 0xbe, 0x83,
+	0x04, 0x99, 0xf2, 0xf8, 0x3a, 0x54, 0xe2, 0x73, 0x6f, 0xe7, 0x87, 0x4f, 0x28, 0x47, 0xea, 0x32,
+	0x95, 0x9a, 0xc7, 0x50, 0xc6, 0x81, 0x31, 0xcb, 0x86, 0x63, 0x2f, 0xa0, 0xf8, 0xe8, 0xe4, 0x9b,
+	0xc9, 0x9c, 0x43, 0xf9, 0x74, 0xec, 0x9d, 0x51, 0xf6, 0xd5, 0x25, 0xfd, 0xe6, 0xef, 0x83, 0x11,
+	0xfb, 0x23, 0xa5, 0x8b, 0x79, 0x69, 0xa7, 0x4e, 0x0e, 0x68, 0x17, 0x09, 0x6f, 0xfe, 0x0c, 0x0c,
+	0xf2, 0x00, 0xad, 0x31, 0x5f, 0xd3, 0x76, 0x44, 0xbf, 0xf9, 0x1a, 0x18, 0x54, 0x60, 0x69, 0x57,
+	0xd2, 0x02, 0x66, 0x49, 0xa2, 0xa2, 0x28, 0x8c, 0xfc, 0x98, 0xba, 0x04, 0xaa, 0x72, 0x08, 0xff,
+	0x5f, 0x58, 0x19, 0x86, 0x97, 0x41, 0xa2, 0x22, 0x77, 0x4e, 0xd3, 0x89, 0x7c, 0x5b, 0xd1, 0xfc,
+	0x5b, 0x11, 0x2a, 0xba, 0x0f, 0xf1, 0x25, 0x28, 0xfa, 0xa3, 0xd4, 0x81, 0xa2, 0x3f, 0x42, 0x97,
+	0x02, 0x5c, 0x68, 0xba, 0x20, 0xfc, 0xcd, 0xdf, 0x83, 0x32, 0xee, 0x2d, 0x4d, 0x7b, 0x6d, 0x3d,
+	0x04, 0xa3, 0xc7, 0x7e, 0xdc, 0x9d, 0x0e, 0x69, 0xbe, 0x9a, 0xd4, 0x02, 0xe6, 0x46, 0x8c, 0x6a,
+	0x7b, 0xf4, 0xe4, 0x07, 0xa6, 0xb1, 0x59, 0x78, 0x54, 0x96, 0x73, 0x00, 0xb5, 0xde, 0x90, 0x1c,
+	0xb3, 0x47, 0x66, 0x65, 0xb3, 0xf0, 0x68, 0x51, 0xce, 0x01, 0x6e, 0x42, 0x15, 0x77, 0xd8, 0xf5,
+	0xce, 0xd2, 0x82, 0xc9, 0x44, 0x74, 0x2f, 0xf2, 0x82, 0x0b, 0xaa, 0x13, 0x43, 0xd2, 0x6f, 0xcc,
+	0xb3, 0x61, 0x38, 0x99, 0x2a, 0xec, 0xa7, 0x2f, 0xd5, 0xb1, 0x1f, 0xc4, 0x54, 0x24, 0x86, 0xbc,
+	0x09, 0x63, 0x9d, 0x0c, 0xa3, 0x30, 0x8e, 0xcf, 0x3d, 0x3f, 0x6a, 0x85, 0x23, 0x45, 0x75, 0x52,
+	0x97, 0xd7, 0xc1, 0x59, 0x54, 0x1a, 0xb9, 0xa8, 0x34, 0xa1, 0xf6, 0x65, 0xe8, 0x07, 0x58, 0x48,
+	0x69, 0x31, 0xcc, 0x64, 0x5c, 0xcb, 0x58, 0x79, 0x2f, 0x15, 0x29, 0x17, 0x49, 0x39, 0x07, 0x9a,
+	0x3f, 0x5f, 0x85, 0x5a, 0xd6, 0xc2, 0xef, 0x0c, 0xf8, 0x1e, 0x2c, 0xd2, 0x59, 0x70, 0x75, 0x34,
+	0x1d, 0x79, 0xc9, 0x2c, 0x6f, 0x3f, 0xb8, 0xeb, 0x0c, 0xd8, 0x16, 0x39, 0xa6, 0xbc, 0x6e, 0x87,
+	0x71, 0x18, 0xa9, 0x71, 0xe2, 0x51, 0x9c, 0x6a, 0x52, 0x0b, 0xfc, 0x31, 0xb0, 0x48, 0x4d, 0xc2,
+	0x97, 0x6a, 0xa4, 0x6d, 0xed, 0x91, 0x3e, 0x35, 0x0c, 0x79, 0x0b, 0xe7, 0x1f, 0x43, 0xf9, 0x24,
+	0x9c, 0x9c, 0x50, 0xb8, 0x1a, 0x3b, 0x0f, 0xee, 0xf4, 0x60, 0x37, 0x9c, 0x9c, 0x48, 0xa2, 0xf1,
+	0xcf, 0xa0, 0xe6, 0x07, 0xa7, 0x2a, 0x0a, 0xc2, 0xec, 0xb8, 0x78, 0x78, 0xa7, 0x89, 0xad, 0x49,
+	0x72, 0xc6, 0xe6, 0x9f, 0x80, 0xe1, 0x27, 0x6a, 0x92, 0x9d, 0x1a, 0x77, 0xcf, 0x64, 0x27, 0x6a,
+	0x22, 0x35, 0x0f, 0xa7, 0x3a, 0x0f, 0xe3, 0xc4, 0x3b, 0x53, 0xb1, 0x59, 0x7b, 0xcb, 0x54, 0x1d,
+	0x4d, 0x92, 0x33, 0x76, 0x33, 0x84, 0x65, 0xbd, 0x40, 0xf1, 0xd3, 0x4b, 0x7f, 0x3a, 0x51, 0x81,
+	0x8e, 0xc2, 0xd5, 0x54, 0xcd, 0xa2, 0x70, 0x35, 0x55, 0x7c, 0x13, 0x1a, 0xde, 0x64, 0x12, 0x4a,
+	0x45, 0xad, 0x39, 0x2d, 0xbe, 0x3c, 0x84, 0xed, 0x13, 0x45, 0x3b, 0x38, 0xf4, 0xce, 0xbc, 0x37,
+	0x7e, 0x90, 0x1d, 0x29, 0x37, 0xd0, 0xe6, 0x6f, 0x0b, 0xb0, 0x98, 0xce, 0x38, 0x0c, 0x83, 0x70,
+	0x72, 0x85, 0x81, 0x99, 0x84, 0x81, 0xba, 0x4a, 0x27, 0xd4, 0x02, 0x8e, 0xa7, 0x32, 0x97, 0x9e,
+	0x7b, 0xe3, 0xcb, 0x6c, 0xd2, 0x1b, 0x28, 0xff, 0x14, 0x56, 0xc9, 0xc0, 0x99, 0xaa, 0x20, 0x71,
+	0xcf, 0xfd, 0x98, 0x8e, 0xe1, 0x74, 0xf2, 0xbb, 0x54, 0x74, 0xe4, 0x24, 0x5e, 0x94, 0x1c, 0xd2,
+	0xa4, 0xba, 0x0b, 0xe4, 0x90, 0xe6, 0xaf, 0x0b, 0xd0, 0xd0, 0x1e, 0x3a, 0x89, 0x97, 0x50, 0xe2,
+	0x5c, 0xf8, 0xe3, 0x71, 0x9c, 0xf9, 0x47, 0x02, 0xb6, 0xc5, 0x91, 0xf2, 0x92, 0xf3, 0x38, 0xf5,
+	0x2b, 0x95, 0xb0, 0x38, 0xbd, 0x38, 0xce, 0xf5, 0xa1, 0x4c, 0xc4, 0x7d, 0x9d, 0xbc, 0x9c, 0x66,
+	0x7d, 0x87, 0x7e, 0xe3, 0xd8, 0xd4, 0x66, 0x29, 0xa7, 0x0c, 0xa9, 0x05, 0x64, 0x4e, 0xfd, 0xe0,
+	0x8c, 0x2a, 0xdf, 0x90, 0xf4, 0xbb, 0xf9, 0xcf, 0x0a, 0x2c, 0xe4, 0xd3, 0x1b, 0x6b, 0x4e, 0xa5,
+	0xa9, 0x99, 0x7a, 0x36, 0x93, 0xf9, 0x23, 0xa8, 0x67, 0xe7, 0x73, 0x56, 0x30, 0x40, 0x09, 0x41,
+	0x97, 0x3c, 0x39, 0x57, 0xe2, 0x32, 0xbc, 0xe0, 0x6c, 0xac, 0xbe, 0x48, 0xbd, 0x4d, 0x25, 0x6c,
+	0x7c, 0xe7, 0xd3, 0xd4, 0xd5, 0xe2, 0xf9, 0x14, 0x1d, 0xf5, 0xa2, 0x49, 0x18, 0x65, 0x8e, 0x92,
+	0x80, 0x1d, 0xe3, 0x74, 0xec, 0xc5, 0xe7, 0xed, 0xcb, 0xc8, 0xc3, 0xf1, 0xc8, 0xe3, 0xa2, 0xbc,
+	0x0e, 0xce, 0x1a, 0x64, 0xf5, 0x7b, 0x1a, 0x64, 0x2d, 0xdf, 0x20, 0x33, 0xc7, 0x5e, 0xa4, 0xdd,
+	0x2a, 0x95, 0xb0, 0x9d, 0x9c, 0x7b, 0x71, 0x47, 0x8d, 0x27, 0x2a, 0xa1, 0x06, 0x55, 0x93, 0x73,
+	0x00, 0xaf, 0x03, 0xe7, 0x5e, 0xdc, 0x56, 0xa7, 0x97, 0xb1, 0xda, 0xf7, 0x13, 0x6a, 0x52, 0x35,
+	0x79, 0x0d, 0xe3, 0xbb, 0x50, 0x9f, 0xe5, 0x90, 0xb9, 0x40, 0x9b, 0xf3, 0xe1, 0x5b, 0xba, 0xc9,
+	0xac, 0x30, 0xe4, 0xdc, 0x8c, 0x5a, 0xe5, 0xb9, 0x17, 0x9c, 0xa9, 0xd1, 0x53, 0x5f, 0x8d, 0x47,
+	0x31, 0x35, 0xb6, 0x45, 0x79, 0x1d, 0xe4, 0x3f, 0x86, 0xaa, 0xd2, 0x49, 0x6e, 0x2e, 0x51, 0xcf,
+	0xd8, 0x7a, 0xdb, 0x3c, 0x9a, 0x29, 0x33, 0x13, 0xfe, 0x04, 0x8c, 0x18, 0x13, 0x90, 0xae, 0x05,
+	0x8d, 0x9d, 0xcd, 0xb7, 0xd8, 0x52, 0xa2, 0x4a, 0x4d, 0xe7, 0x1f, 0x41, 0xed, 0xa5, 0x1a, 0x87,
+	0x43, 0x3f, 0xb9, 0xa2, 0xab, 0xd7, 0xf5, 0xd8, 0xcf, 0x74, 0xb8, 0x93, 0x7e, 0xdc, 0xbe, 0x1c,
+	0x5e, 0x60, 0xaa, 0xad, 0xe8, 0x9d, 0x9c, 0x01, 0x5a, 0x7b, 0xec, 0x8d, 0x49, 0xcb, 0x33, 0x6d,
+	0x0a, 0x60, 0x0d, 0xf9, 0xb1, 0xe5, 0x47, 0x27, 0x61, 0x14, 0x28, 0x73, 0x95, 0xd4, 0x39, 0x04,
+	0x93, 0xd3, 0x8f, 0x9d, 0x61, 0x38, 0x55, 0x23, 0x73, 0x8d, 0xb4, 0x33, 0x59, 0xdb, 0x52, 0x38,
+	0x70, 0xe8, 0x77, 0x32, 0xdb, 0x0c, 0xd1, 0xfa, 0xfe, 0xd8, 0x0b, 0x12, 0xd4, 0xaf, 0x67, 0xfa,
+	0x0c, 0xc1, 0x5e, 0xe4, 0xc7, 0x52, 0x8d, 0x43, 0x6f, 0x84, 0x84, 0xfb, 0x44, 0xc8, 0x43, 0x98,
+	0x05, 0xde, 0x90, 0x8e, 0x35, 0xe5, 0x4d, 0xc3, 0xc0, 0x34, 0xf5, 0xe5, 0x36, 0x8f, 0xe1, 0x95,
+	0x20, 0x2f, 0xdb, 0xc1, 0x48, 0xbd, 0x36, 0x1f, 0xe8, 0x2b, 0xc1, 0x2d, 0x45, 0xf3, 0xef, 0x05,
+	0x28, 0x63, 0x6b, 0xc7, 0x6d, 0x19, 0x7a, 0x51, 0xe4, 0xab, 0x68, 0x56, 0x76, 0x73, 0x00, 0xb7,
+	0x3e, 0x2b, 0x2d, 0xb3, 0x78, 0x7b, 0xeb, 0x33, 0x9d, 0xde, 0x5c, 0x5a, 0x90, 0x1a, 0xa5, 0xe7,
+	0xd1, 0x1c, 0xc0, 0xf2, 0x8f, 0xfd, 0xf4, 0xa6, 0x5d, 0x97, 0xf4, 0x1b, 0x4f, 0xf1, 0xc4, 0x9f,
+	0x28, 0x37, 0x14, 0xaf, 0xa7, 0xe3, 0x90, 0x6e, 0x8b, 0x06, 0xd5, 0xda, 0x4d, 0x18, 0xc7, 0x1e,
+	0x51, 0xae, 0x47, 0xe9, 0xdd, 0xc1, 0x90, 0x73, 0x00, 0xdb, 0xaa, 0x16, 0xfa, 0x51, 0x78, 0x16,
+	0xa9, 0x38, 0xa6, 0xaa, 0x2c, 0xca, 0x1b, 0x68, 0xf3, 0x05, 0x54, 0xd3, 0x73, 0xe9, 0xd6, 0x1d,
+	0xe8, 0x21, 0xd4, 0x93, 0xf3, 0x28, 0x7c, 0x45, 0x13, 0xe8, 0xe6, 0x37, 0x07, 0xf8, 0x26, 0x18,
+	0xa7, 0x7e, 0xa4, 0xb2, 0xb7, 0x5a, 0x7e, 0xfd, 0x5a, 0xd1, 0x94, 0x50, 0xc6, 0xb3, 0xeb, 0xae,
+	0xbb, 0x15, 0x9d, 0x3b, 0xc5, 0xdc, 0xb9, 0x93, 0xdf, 0xd0, 0xd2, 0x77, 0x6f, 0x68, 0xf3, 0x0f,
+	0x45, 0xa8, 0xa6, 0x87, 0xdb, 0xad, 0x71, 0xff, 0xdd, 0xa0, 0x7c, 0xa6, 0xeb, 0x4d, 0xa5, 0x17,
+	0xb9, 0xad, 0xb7, 0x9d, 0xa0, 0xdb, 0x58, 0x71, 0x4a, 0x57, 0x9c, 0xba, 0x9e, 0x14, 0xe5, 0x9b,
+	0x49, 0xa1, 0x5b, 0xa9, 0x91, 0xb5, 0xd2, 0xad, 0x5f, 0x16, 0xc0, 0x20, 0x73, 0x5e, 0x83, 0xb2,
+	0xdd, 0x3e, 0x10, 0xec, 0x1e, 0x67, 0xb0, 0xb0, 0x2b, 0xec, 0xee, 0xde, 0xe0, 0xa8, 0xeb, 0xda,
+	0xa2, 0xcd, 0x0a, 0xfc, 0x1d, 0x58, 0xd9, 0x13, 0xae, 0x8b, 0x58, 0xdf, 0x6e, 0xed, 0x8b, 0xf6,
+	0xe0, 0xa8, 0xcf, 0x8a, 0x7c, 0x05, 0x16, 0x35, 0xb1, 0x65, 0x49, 0x89, 0xcc, 0x12, 0x5f, 0x03,
+	0xf6, 0xb4, 0x77, 0x70, 0xd0, 0x3b, 0x26, 0xee, 0x81, 0xf5, 0x42, 0x48, 0x56, 0xe6, 0xab, 0xb0,
+	0x9c, 0xd9, 0xb7, 0x65, 0xaf, 0xdf, 0x17, 0x6d, 0x66, 0xf0, 0x06, 0x54, 0xa5, 0x70, 0x5a, 0x47,
+	0xa2, 0xcd, 0x2a, 0x38, 0x7b, 0x5b, 0x58, 0x6d, 0x56, 0x6d, 0xfe, 0xa9, 0x01, 0x65, 0xba, 0xab,
+	0x2d, 0x41, 0x31, 0x88, 0xb2, 0xad, 0x0b, 0xf0, 0xb1, 0x5e, 0x51, 0x2f, 0x55, 0x90, 0x64, 0x87,
+	0xc8, 0xfa, 0xcd, 0x97, 0xf4, 0xb6, 0x40, 0xb5, 0x4c, 0x59, 0xcd, 0x5f, 0x34, 0xc0, 0x20, 0x84,
+	0x7f, 0x02, 0xe5, 0x0b, 0x3f, 0xd0, 0x61, 0x58, 0xda, 0x79, 0xf7, 0x6e, 0xbb, 0xed, 0x7d, 0x3f,
+	0x18, 0x49, 0x22, 0xf2, 0x9f, 0x00, 0x78, 0x49, 0x12, 0xf9, 0x27, 0x97, 0xf3, 0x4b, 0xde, 0xe6,
+	0x77, 0x98, 0x59, 0x19, 0x51, 0xe6, 0x6c, 0x9a, 0x5f, 0x97, 0xa1, 0x3e, 0xd3, 0xf0, 0x1f, 0x5d,
+	0x73, 0xe0, 0xbf, 0xbf, 0x6f, 0xa4, 0xbc, 0x2b, 0x9b, 0xd0, 0x88, 0x93, 0xc8, 0x0f, 0xce, 0xe6,
+	0xf7, 0x8e, 0xba, 0xcc, 0x43, 0xc8, 0x08, 0x2e, 0x27, 0x27, 0x2a, 0xd2, 0x8c, 0x12, 0x3d, 0x3a,
+	0xf3, 0x10, 0xbd, 0x5b, 0x2f, 0xe3, 0x24, 0x9c, 0xd0, 0xbb, 0xa8, 0x9c, 0xbe, 0x5b, 0x67, 0xc8,
+	0xd6, 0xef, 0x4a, 0x50, 0xc6, 0x29, 0xf9, 0x22, 0xd4, 0x45, 0xd7, 0xb5, 0xdd, 0x17, 0x03, 0xbb,
+	0xcd, 0xee, 0x71, 0x80, 0xca, 0x73, 0xbb, 0xe5, 0xda, 0x87, 0xac, 0x80, 0xbf, 0xf7, 0xed, 0x83,
+	0x03, 0x21, 0x59, 0x91, 0x2f, 0x40, 0xcd, 0x72, 0x1c, 0xdb, 0x71, 0x85, 0x64, 0x25, 0x0c, 0x9d,
+	0x2b, 0xbe, 0x70, 0x59, 0x99, 0x2f, 0x01, 0x88, 0xe7, 0xa2, 0xeb, 0x0e, 0xba, 0xd6, 0xa1, 0x60,
+	0x06, 0xda, 0xb4, 0x8e, 0x1c, 0xb7, 0x77, 0xc8, 0x2a, 0x98, 0x42, 0x6e, 0x47, 0xf6, 0x8e, 0x85,
+	0x1c, 0xcc, 0xa7, 0xa8, 0xa2, 0xb1, 0x63, 0xbb, 0x82, 0xd5, 0x30, 0x99, 0xfa, 0xb2, 0xf7, 0x4c,
+	0xb4, 0x5c, 0xfb, 0x40, 0xa0, 0xb2, 0x8e, 0x19, 0x62, 0xbb, 0xe2, 0x10, 0x05, 0xc0, 0xc1, 0x3b,
+	0x3d, 0xc7, 0xb5, 0xf6, 0x48, 0xd9, 0xc0, 0xc1, 0x8f, 0x85, 0xd5, 0xef, 0x75, 0xd9, 0x02, 0x5f,
+	0x86, 0x86, 0xed, 0x0c, 0x3a, 0xc2, 0x6a, 0x3b, 0x9d, 0x9e, 0xcb, 0x16, 0xf9, 0x3a, 0xf0, 0xbe,
+	0xe8, 0x0a, 0x57, 0x5a, 0xae, 0x68, 0x0f, 0x7a, 0xbb, 0x38, 0xac, 0xc3, 0x96, 0x52, 0x62, 0xb7,
+	0x37, 0x70, 0x5a, 0xbd, 0xbe, 0x60, 0xcb, 0x98, 0xaf, 0xb6, 0x33, 0x40, 0xcf, 0x8e, 0xf6, 0x3a,
+	0x03, 0xe7, 0xb0, 0xb7, 0x2f, 0x18, 0x43, 0x67, 0x6d, 0x67, 0x60, 0xb9, 0xae, 0xd5, 0xda, 0x17,
+	0x72, 0xb0, 0x7b, 0x60, 0x77, 0xdb, 0x6c, 0x25, 0x83, 0xf5, 0xd2, 0xdb, 0x83, 0xa7, 0x07, 0x96,
+	0xd3, 0x61, 0x1c, 0xb7, 0xa3, 0x6d, 0x3b, 0xae, 0xd5, 0x6d, 0x09, 0xb6, 0x4a, 0x9b, 0x93, 0x1a,
+	0xb2, 0x35, 0x5c, 0x55, 0x47, 0x58, 0x07, 0x6e, 0x67, 0xd0, 0xb6, 0x0e, 0xad, 0x3d, 0xc1, 0xde,
+	0xc1, 0xf2, 0xb2, 0xe4, 0x61, 0x4f, 0x66, 0xc8, 0x3a, 0x2e, 0x45, 0x93, 0xd8, 0x7d, 0x5e, 0x07,
+	0x83, 0xb4, 0xcc, 0xc4, 0x68, 0x74, 0x6c, 0x77, 0xb0, 0x27, 0x7b, 0x47, 0x7d, 0xf6, 0x60, 0xeb,
+	0xf7, 0x46, 0x1a, 0xa5, 0x1a, 0x94, 0x9f, 0x1d, 0x1d, 0xf6, 0xd9, 0x3d, 0xfc, 0xf5, 0xd4, 0x96,
+	0x82, 0x15, 0xf0, 0x57, 0xe7, 0x48, 0xba, 0xac, 0x88, 0x9b, 0x46, 0x8e, 0x51, 0x39, 0xd6, 0xa0,
+	0x8c, 0x51, 0x63, 0x65, 0x74, 0x44, 0xf6, 0x8e, 0xba, 0xed, 0x81, 0xe3, 0x5a, 0xd2, 0xa5, 0x02,
+	0x5c, 0x84, 0xba, 0x73, 0x6c, 0xf5, 0x07, 0xae, 0xb0, 0x30, 0x42, 0x4b, 0x00, 0x6d, 0xdb, 0x69,
+	0xf5, 0xba, 0x5d, 0xd1, 0x72, 0x59, 0x15, 0xfd, 0x6c, 0x75, 0x2c, 0x77, 0x70, 0x28, 0x1c, 0x07,
+	0xfd, 0xac, 0xe5, 0xe2, 0x59, 0xc7, 0xf1, 0x0e, 0x2d, 0xb7, 0xd5, 0x99, 0x8d, 0x07, 0xb8, 0xe9,
+	0x7b, 0xd6, 0xa1, 0x18, 0xf4, 0x3b, 0x96, 0x23, 0x06, 0xad, 0x8e, 0xd5, 0xdd, 0x13, 0x18, 0xa9,
+	0x15, 0x58, 0xa4, 0x8d, 0x9d, 0x51, 0x17, 0xe6, 0x90, 0xf8, 0xa2, 0x6f, 0x4b, 0xd1, 0x66, 0x8b,
+	0x08, 0xb5, 0x45, 0xab, 0xf7, 0x62, 0xc6, 0x5a, 0x9a, 0x43, 0x19, 0x6b, 0x99, 0x9b, 0xb0, 0x86,
+	0x2b, 0x1e, 0xec, 0x49, 0xd1, 0xb5, 0xda, 0xf3, 0x21, 0xd9, 0x2d, 0x4d, 0x66, 0xb3, 0x82, 0x9a,
+	0xce, 0x35, 0xfc, 0xa0, 0xe7, 0xd8, 0xbd, 0x2e, 0xe3, 0xd8, 0x97, 0x68, 0xaf, 0x72, 0xe0, 0x2a,
+	0xce, 0xaa, 0x13, 0x6b, 0xe0, 0x1c, 0xdb, 0x6e, 0xab, 0xc3, 0xd6, 0x30, 0x6d, 0x76, 0x7b, 0x87,
+	0xbb, 0xd4, 0xfc, 0x8e, 0xfa, 0x3a, 0x86, 0x04, 0x64, 0xdd, 0x6c, 0x1d, 0x13, 0x49, 0x53, 0x0e,
+	0xac, 0xae, 0x3b, 0xd8, 0x15, 0x7b, 0x76, 0x97, 0xdd, 0xc7, 0x2d, 0xc9, 0xa1, 0xd6, 0x6e, 0x8f,
+	0x9c, 0x35, 0x67, 0xf6, 0x84, 0x8b, 0x36, 0x7b, 0x80, 0xb9, 0xa5, 0x47, 0x14, 0x4f, 0x8f, 0x9c,
+	0x74, 0x5d, 0xac, 0xc9, 0xef, 0xc3, 0x6a, 0x1e, 0xce, 0x46, 0x78, 0x77, 0xee, 0x01, 0x29, 0xda,
+	0xec, 0x21, 0x75, 0x63, 0x44, 0x68, 0x2d, 0x6d, 0xd1, 0x66, 0xef, 0x71, 0x0e, 0x4b, 0xd9, 0xb2,
+	0xa9, 0xf8, 0xba, 0x6c, 0x23, 0x8f, 0x1d, 0x58, 0x5d, 0xe4, 0xbd, 0x4f, 0x65, 0x81, 0x85, 0x96,
+	0xae, 0x6f, 0x13, 0x53, 0x83, 0x00, 0x5c, 0x1f, 0xfb, 0x00, 0x6d, 0xb2, 0xda, 0x4b, 0x29, 0x5b,
+	0xb8, 0x77, 0x19, 0x96, 0xb5, 0xf1, 0xff, 0xca, 0x13, 0xa9, 0x5b, 0xb4, 0xd9, 0x87, 0xcd, 0x7d,
+	0xa8, 0xa6, 0x1f, 0x2f, 0xef, 0x7c, 0x53, 0xdf, 0x75, 0xd2, 0x9a, 0x50, 0x9d, 0xa8, 0x38, 0xf6,
+	0xce, 0x54, 0xfa, 0x9d, 0x27, 0x13, 0x9b, 0xbf, 0x29, 0x81, 0xa1, 0x5f, 0x4e, 0x37, 0x8f, 0x07,
+	0xfa, 0x88, 0xe1, 0x45, 0x09, 0x3d, 0xed, 0xd3, 0x9b, 0xc0, 0x0c, 0xc0, 0x1b, 0xd6, 0x69, 0xa4,
+	0xd4, 0x1b, 0x85, 0x1f, 0xe1, 0x44, 0x30, 0x22, 0x96, 0x7e, 0x65, 0xdc, 0x56, 0xe0, 0xfc, 0x2a,
+	0xe5, 0xe8, 0x13, 0x34, 0x13, 0xf9, 0x07, 0x50, 0x79, 0xe5, 0x07, 0x81, 0xd2, 0x6f, 0x8f, 0x6b,
+	0x0f, 0x88, 0x54, 0x81, 0x8f, 0x85, 0x48, 0x79, 0x71, 0xfa, 0x00, 0x31, 0x64, 0x2a, 0xe1, 0xad,
+	0x89, 0x5e, 0x54, 0xb9, 0xaf, 0x3e, 0x55, 0xfd, 0xed, 0xe3, 0x06, 0xcc, 0x9f, 0xc0, 0x3a, 0x41,
+	0xad, 0x5b, 0x9f, 0x89, 0xf4, 0xb7, 0x94, 0xef, 0xd0, 0xf2, 0xcf, 0xa1, 0x81, 0x8f, 0xfd, 0xde,
+	0x65, 0x32, 0x0c, 0xd3, 0xcf, 0x8f, 0x4b, 0x3b, 0xef, 0xdd, 0xfa, 0x2c, 0xbc, 0xbd, 0x3b, 0x27,
+	0xc9, 0xbc, 0xc5, 0xd6, 0xe7, 0xd0, 0xc8, 0xe9, 0xb0, 0x7b, 0x74, 0x7b, 0x5d, 0xbc, 0x12, 0x34,
+	0xa0, 0x9a, 0xa5, 0x6a, 0x01, 0x85, 0x2c, 0xeb, 0xe8, 0x2c, 0x98, 0x25, 0x5c, 0xa9, 0xf9, 0xc7,
+	0x02, 0xc0, 0xfc, 0x53, 0xf2, 0x7f, 0x78, 0x5b, 0xcb, 0x32, 0xa1, 0x94, 0xcb, 0x84, 0xcc, 0x22,
+	0x17, 0x8b, 0x39, 0xa0, 0x2f, 0x90, 0x49, 0x18, 0xd0, 0xd3, 0x8e, 0x28, 0xfa, 0x66, 0x73, 0x03,
+	0xe5, 0x8f, 0x01, 0x92, 0xc8, 0x43, 0xaf, 0xc2, 0xe8, 0x2a, 0xfd, 0xfe, 0x91, 0xbf, 0x77, 0xe5,
+	0xb4, 0x8f, 0xf7, 0xd3, 0x4f, 0x89, 0x4b, 0x00, 0x47, 0x5d, 0xec, 0xf5, 0x7b, 0x5d, 0x81, 0x87,
+	0xe1, 0x22, 0xd4, 0x5d, 0x21, 0x65, 0x4f, 0xda, 0x8e, 0xab, 0xaf, 0x44, 0xad, 0xde, 0x51, 0xd7,
+	0x15, 0x72, 0x30, 0x87, 0x8b, 0xd4, 0x53, 0xfb, 0xa2, 0xe5, 0x5a, 0x6e, 0x4f, 0xb2, 0xd2, 0xae,
+	0xf9, 0xd5, 0x37, 0x1b, 0x85, 0xaf, 0xbf, 0xd9, 0x28, 0xfc, 0xf5, 0x9b, 0x8d, 0xc2, 0xaf, 0xbe,
+	0xdd, 0xb8, 0xf7, 0xf5, 0xb7, 0x1b, 0xf7, 0xfe, 0xfc, 0xed, 0xc6, 0xbd, 0x93, 0x0a, 0xfd, 0xe1,
+	0xf1, 0xff, 0xff, 0x1a, 0x00, 0x09, 0xbe, 0xbe, 0xc2, 0x00, 0x19, 0x00, 0x00,
+}
+
+func (m *Point) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Point) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Point) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Z != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Z))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Y != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Y))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.X))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Footer != nil {
+		{
+			size, err := m.Footer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Projectiles) > 0 {
+		for iNdEx := len(m.Projectiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projectiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectileSampleRate != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ProjectileSampleRate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MinifierVersion) > 0 {
+		i -= len(m.MinifierVersion)
+		copy(dAtA[i:], m.MinifierVersion)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.MinifierVersion)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Filestamp) > 0 {
+		i -= len(m.Filestamp)
+		copy(dAtA[i:], m.Filestamp)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Filestamp)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NetworkProtocol != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.NetworkProtocol))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PlaybackFrames != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.PlaybackFrames))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PlaybackTicks != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.PlaybackTicks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PlaybackTime != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PlaybackTime))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.ClientName) > 0 {
+		i -= len(m.ClientName)
+		copy(dAtA[i:], m.ClientName)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.ClientName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ServerName) > 0 {
+		i -= len(m.ServerName)
+		copy(dAtA[i:], m.ServerName)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.ServerName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PositionSampleRate != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.PositionSampleRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotRate != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.SnapshotRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TickRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TickRate))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Map) > 0 {
+		i -= len(m.Map)
+		copy(dAtA[i:], m.Map)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Map)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Footer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Footer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Footer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Footer_Team) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Footer_Team) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Footer_Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sides) > 0 {
+		dAtA4 := make([]byte, len(m.Sides)*10)
+		var j3 int
+		for _, num := range m.Sides {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintReplay(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Flag) > 0 {
+		i -= len(m.Flag)
+		copy(dAtA[i:], m.Flag)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Flag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClanName) > 0 {
+		i -= len(m.ClanName)
+		copy(dAtA[i:], m.ClanName)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.ClanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Footer_Score) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Footer_Score) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Footer_Score) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CounterTerrorists != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.CounterTerrorists))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Terrorists != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Terrorists))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Entity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Entity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Entity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LeaveTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.LeaveTick))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.JoinTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.JoinTick))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Tick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CrosshairCode) > 0 {
+		i -= len(m.CrosshairCode)
+		copy(dAtA[i:], m.CrosshairCode)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.CrosshairCode)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CompetitiveWins != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.CompetitiveWins))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Rank != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ClanTag) > 0 {
+		i -= len(m.ClanTag)
+		copy(dAtA[i:], m.ClanTag)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.ClanTag)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AccountId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.AccountId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SteamId64 != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.SteamId64))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsNpc {
+		i--
+		if m.IsNpc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Team != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Team))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hostages) > 0 {
+		for iNdEx := len(m.Hostages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hostages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Infernos) > 0 {
+		for iNdEx := len(m.Infernos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infernos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Bomb != nil {
+		{
+			size, err := m.Bomb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemovedEntityIds) > 0 {
+		dAtA7 := make([]byte, len(m.RemovedEntityIds)*10)
+		var j6 int
+		for _, num1 := range m.RemovedEntityIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintReplay(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Delta {
+		i--
+		if m.Delta {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EntityUpdates) > 0 {
+		for iNdEx := len(m.EntityUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Tick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_EntityEquipment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_EntityEquipment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_EntityEquipment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmmoInMagazine != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.AmmoInMagazine))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AmmoReserve != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.AmmoReserve))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_EntityEconomy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_EntityEconomy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_EntityEconomy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartMoney != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.StartMoney))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MoneySpentThisRound != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.MoneySpentThisRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EquipmentValue != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.EquipmentValue))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Money != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Money))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_EntityStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_EntityStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_EntityStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ping != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Ping))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Score != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mvps != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Mvps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Assists != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Assists))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Deaths != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Deaths))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kills != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Kills))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_EntityUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_EntityUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_EntityUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveWeaponIndex != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ActiveWeaponIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ActiveWeapon != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ActiveWeapon))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.IsReloading {
+		i--
+		if m.IsReloading {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.IsPlanting {
+		i--
+		if m.IsPlanting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.IsDefusing {
+		i--
+		if m.IsDefusing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.IsScoped {
+		i--
+		if m.IsScoped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.IsAirborne {
+		i--
+		if m.IsAirborne {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.IsWalking {
+		i--
+		if m.IsWalking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsDucking {
+		i--
+		if m.IsDucking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Velocity != nil {
+		{
+			size, err := m.Velocity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Economy != nil {
+		{
+			size, err := m.Economy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ChangedFields != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ChangedFields))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Equipment) > 0 {
+		for iNdEx := len(m.Equipment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equipment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.HasDefuseKit {
+		i--
+		if m.HasDefuseKit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HasHelmet {
+		i--
+		if m.HasHelmet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AngleY != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.AngleY))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IsNpc {
+		i--
+		if m.IsNpc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Team != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Team))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FlashDuration != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FlashDuration))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.Armor != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Armor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Hp != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Hp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AngleX != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.AngleX))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EntityId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.EntityId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_Bomb) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_Bomb) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_Bomb) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefuseProgress != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DefuseProgress))))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.DefuserId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.DefuserId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeToExplosion != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.TimeToExplosion))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.Site) > 0 {
+		i -= len(m.Site)
+		copy(dAtA[i:], m.Site)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Site)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsPlanted {
+		i--
+		if m.IsPlanted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CarrierId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.CarrierId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_Inferno) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_Inferno) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_Inferno) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fires) > 0 {
+		for iNdEx := len(m.Fires) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fires[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ThrowerId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ThrowerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_Item) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_Item) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Snapshot_Hostage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Snapshot_Hostage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Snapshot_Hostage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hp != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Hp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CarrierId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.CarrierId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplay(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nr != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Nr))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Tick_Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Tick_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Tick_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Tick_Event_Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Tick_Event_Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Tick_Event_Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomName) > 0 {
+		i -= len(m.CustomName)
+		copy(dAtA[i:], m.CustomName)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.CustomName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumberValue != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NumberValue))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.StringValue) > 0 {
+		i -= len(m.StringValue)
+		copy(dAtA[i:], m.StringValue)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.StringValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Warning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Warning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Warning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintReplay(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Round) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Round) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BombOutcome != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.BombOutcome))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ScoreCounterTerrorists != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ScoreCounterTerrorists))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ScoreTerrorists != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ScoreTerrorists))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Reason != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Winner != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Winner))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.EndTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FreezeTimeEndTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.FreezeTimeEndTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nr != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Nr))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Replay_Projectile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Replay_Projectile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Replay_Projectile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trajectory) > 0 {
+		for iNdEx := len(m.Trajectory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trajectory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DetonationTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.DetonationTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ThrowTick != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ThrowTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ThrowerId != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.ThrowerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintReplay(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReplay(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReplay(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Point) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X != 0 {
+		n += 1 + sovReplay(uint64(m.X))
+	}
+	if m.Y != 0 {
+		n += 1 + sovReplay(uint64(m.Y))
+	}
+	if m.Z != 0 {
+		n += 1 + sovReplay(uint64(m.Z))
+	}
+	return n
+}
+
+func (m *Replay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Projectiles) > 0 {
+		for _, e := range m.Projectiles {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if m.Footer != nil {
+		l = m.Footer.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	return n
+}
+
+func (m *Replay_Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Map)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.TickRate != 0 {
+		n += 9
+	}
+	if m.SnapshotRate != 0 {
+		n += 1 + sovReplay(uint64(m.SnapshotRate))
+	}
+	if m.PositionSampleRate != 0 {
+		n += 1 + sovReplay(uint64(m.PositionSampleRate))
+	}
+	l = len(m.ServerName)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	l = len(m.ClientName)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.PlaybackTime != 0 {
+		n += 9
+	}
+	if m.PlaybackTicks != 0 {
+		n += 1 + sovReplay(uint64(m.PlaybackTicks))
+	}
+	if m.PlaybackFrames != 0 {
+		n += 1 + sovReplay(uint64(m.PlaybackFrames))
+	}
+	if m.NetworkProtocol != 0 {
+		n += 1 + sovReplay(uint64(m.NetworkProtocol))
+	}
+	l = len(m.Filestamp)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	l = len(m.MinifierVersion)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.ProjectileSampleRate != 0 {
+		n += 2 + sovReplay(uint64(m.ProjectileSampleRate))
+	}
+	return n
+}
+
+func (m *Replay_Footer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Teams) > 0 {
+		for _, e := range m.Teams {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	return n
+}
+
+func (m *Replay_Footer_Team) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClanName)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	l = len(m.Flag)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if len(m.Sides) > 0 {
+		l = 0
+		for _, e := range m.Sides {
+			l += sovReplay(uint64(e))
+		}
+		n += 1 + sovReplay(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Replay_Footer_Score) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tick != 0 {
+		n += 1 + sovReplay(uint64(m.Tick))
+	}
+	if m.Round != 0 {
+		n += 1 + sovReplay(uint64(m.Round))
+	}
+	if m.Terrorists != 0 {
+		n += 1 + sovReplay(uint64(m.Terrorists))
+	}
+	if m.CounterTerrorists != 0 {
+		n += 1 + sovReplay(uint64(m.CounterTerrorists))
+	}
+	return n
+}
+
+func (m *Replay_Entity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReplay(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.Team != 0 {
+		n += 1 + sovReplay(uint64(m.Team))
+	}
+	if m.IsNpc {
+		n += 2
+	}
+	if m.SteamId64 != 0 {
+		n += 1 + sovReplay(uint64(m.SteamId64))
+	}
+	if m.AccountId != 0 {
+		n += 1 + sovReplay(uint64(m.AccountId))
+	}
+	l = len(m.ClanTag)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovReplay(uint64(m.Rank))
+	}
+	if m.CompetitiveWins != 0 {
+		n += 1 + sovReplay(uint64(m.CompetitiveWins))
+	}
+	l = len(m.CrosshairCode)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovReplay(uint64(m.Tick))
+	}
+	if m.JoinTick != 0 {
+		n += 1 + sovReplay(uint64(m.JoinTick))
+	}
+	if m.LeaveTick != 0 {
+		n += 1 + sovReplay(uint64(m.LeaveTick))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tick != 0 {
+		n += 1 + sovReplay(uint64(m.Tick))
+	}
+	if len(m.EntityUpdates) > 0 {
+		for _, e := range m.EntityUpdates {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if m.Delta {
+		n += 2
+	}
+	if len(m.RemovedEntityIds) > 0 {
+		l = 0
+		for _, e := range m.RemovedEntityIds {
+			l += sovReplay(uint64(e))
+		}
+		n += 1 + sovReplay(uint64(l)) + l
+	}
+	if m.Bomb != nil {
+		l = m.Bomb.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if len(m.Infernos) > 0 {
+		for _, e := range m.Infernos {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if len(m.Hostages) > 0 {
+		for _, e := range m.Hostages {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_EntityEquipment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovReplay(uint64(m.Type))
+	}
+	if m.AmmoReserve != 0 {
+		n += 1 + sovReplay(uint64(m.AmmoReserve))
+	}
+	if m.AmmoInMagazine != 0 {
+		n += 1 + sovReplay(uint64(m.AmmoInMagazine))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_EntityEconomy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Money != 0 {
+		n += 1 + sovReplay(uint64(m.Money))
+	}
+	if m.EquipmentValue != 0 {
+		n += 1 + sovReplay(uint64(m.EquipmentValue))
+	}
+	if m.MoneySpentThisRound != 0 {
+		n += 1 + sovReplay(uint64(m.MoneySpentThisRound))
+	}
+	if m.StartMoney != 0 {
+		n += 1 + sovReplay(uint64(m.StartMoney))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_EntityStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kills != 0 {
+		n += 1 + sovReplay(uint64(m.Kills))
+	}
+	if m.Deaths != 0 {
+		n += 1 + sovReplay(uint64(m.Deaths))
+	}
+	if m.Assists != 0 {
+		n += 1 + sovReplay(uint64(m.Assists))
+	}
+	if m.Mvps != 0 {
+		n += 1 + sovReplay(uint64(m.Mvps))
+	}
+	if m.Score != 0 {
+		n += 1 + sovReplay(uint64(m.Score))
+	}
+	if m.Ping != 0 {
+		n += 1 + sovReplay(uint64(m.Ping))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_EntityUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityId != 0 {
+		n += 1 + sovReplay(uint64(m.EntityId))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if m.AngleX != 0 {
+		n += 1 + sovReplay(uint64(m.AngleX))
+	}
+	if m.Hp != 0 {
+		n += 1 + sovReplay(uint64(m.Hp))
+	}
+	if m.Armor != 0 {
+		n += 1 + sovReplay(uint64(m.Armor))
+	}
+	if m.FlashDuration != 0 {
+		n += 5
+	}
+	if m.Team != 0 {
+		n += 1 + sovReplay(uint64(m.Team))
+	}
+	if m.IsNpc {
+		n += 2
+	}
+	if m.AngleY != 0 {
+		n += 1 + sovReplay(uint64(m.AngleY))
+	}
+	if m.HasHelmet {
+		n += 2
+	}
+	if m.HasDefuseKit {
+		n += 2
+	}
+	if len(m.Equipment) > 0 {
+		for _, e := range m.Equipment {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	if m.ChangedFields != 0 {
+		n += 1 + sovReplay(uint64(m.ChangedFields))
+	}
+	if m.Economy != nil {
+		l = m.Economy.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.Velocity != nil {
+		l = m.Velocity.Size()
+		n += 2 + l + sovReplay(uint64(l))
+	}
+	if m.IsDucking {
+		n += 3
+	}
+	if m.IsWalking {
+		n += 3
+	}
+	if m.IsAirborne {
+		n += 3
+	}
+	if m.IsScoped {
+		n += 3
+	}
+	if m.IsDefusing {
+		n += 3
+	}
+	if m.IsPlanting {
+		n += 3
+	}
+	if m.IsReloading {
+		n += 3
+	}
+	if m.ActiveWeapon != 0 {
+		n += 2 + sovReplay(uint64(m.ActiveWeapon))
+	}
+	if m.ActiveWeaponIndex != 0 {
+		n += 2 + sovReplay(uint64(m.ActiveWeaponIndex))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_Bomb) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CarrierId != 0 {
+		n += 1 + sovReplay(uint64(m.CarrierId))
+	}
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.IsPlanted {
+		n += 2
+	}
+	l = len(m.Site)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.TimeToExplosion != 0 {
+		n += 5
+	}
+	if m.DefuserId != 0 {
+		n += 1 + sovReplay(uint64(m.DefuserId))
+	}
+	if m.DefuseProgress != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_Inferno) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReplay(uint64(m.Id))
+	}
+	if m.ThrowerId != 0 {
+		n += 1 + sovReplay(uint64(m.ThrowerId))
+	}
+	if len(m.Fires) > 0 {
+		for _, e := range m.Fires {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_Item) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReplay(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovReplay(uint64(m.Type))
+	}
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	return n
+}
+
+func (m *Replay_Snapshot_Hostage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReplay(uint64(m.Id))
+	}
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovReplay(uint64(m.State))
+	}
+	if m.CarrierId != 0 {
+		n += 1 + sovReplay(uint64(m.CarrierId))
+	}
+	if m.Hp != 0 {
+		n += 1 + sovReplay(uint64(m.Hp))
+	}
+	return n
+}
+
+func (m *Replay_Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nr != 0 {
+		n += 1 + sovReplay(uint64(m.Nr))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Replay_Tick_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovReplay(uint64(m.Kind))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Replay_Tick_Event_Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovReplay(uint64(m.Kind))
+	}
+	l = len(m.StringValue)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	if m.NumberValue != 0 {
+		n += 9
+	}
+	l = len(m.CustomName)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	return n
+}
+
+func (m *Replay_Warning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tick != 0 {
+		n += 1 + sovReplay(uint64(m.Tick))
+	}
+	if m.Type != 0 {
+		n += 1 + sovReplay(uint64(m.Type))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovReplay(uint64(l))
+	}
+	return n
+}
+
+func (m *Replay_Round) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nr != 0 {
+		n += 1 + sovReplay(uint64(m.Nr))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovReplay(uint64(m.StartTick))
+	}
+	if m.FreezeTimeEndTick != 0 {
+		n += 1 + sovReplay(uint64(m.FreezeTimeEndTick))
+	}
+	if m.EndTick != 0 {
+		n += 1 + sovReplay(uint64(m.EndTick))
+	}
+	if m.Winner != 0 {
+		n += 1 + sovReplay(uint64(m.Winner))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovReplay(uint64(m.Reason))
+	}
+	if m.ScoreTerrorists != 0 {
+		n += 1 + sovReplay(uint64(m.ScoreTerrorists))
+	}
+	if m.ScoreCounterTerrorists != 0 {
+		n += 1 + sovReplay(uint64(m.ScoreCounterTerrorists))
+	}
+	if m.BombOutcome != 0 {
+		n += 1 + sovReplay(uint64(m.BombOutcome))
+	}
+	return n
+}
+
+func (m *Replay_Projectile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReplay(uint64(m.Id))
+	}
+	if m.ThrowerId != 0 {
+		n += 1 + sovReplay(uint64(m.ThrowerId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovReplay(uint64(m.Type))
+	}
+	if m.ThrowTick != 0 {
+		n += 1 + sovReplay(uint64(m.ThrowTick))
+	}
+	if m.DetonationTick != 0 {
+		n += 1 + sovReplay(uint64(m.DetonationTick))
+	}
+	if len(m.Trajectory) > 0 {
+		for _, e := range m.Trajectory {
+			l = e.Size()
+			n += 1 + l + sovReplay(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReplay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReplay(x uint64) (n int) {
+	return sovReplay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Point) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1734,30 +4743,2719 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowReplay
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Point: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Point: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			m.X = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			}
+			m.Y = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Y |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Z", wireType)
+			}
+			m.Z = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Z |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Replay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Replay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Replay_Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entities = append(m.Entities, &Replay_Entity{})
+			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, &Replay_Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, &Replay_Tick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &Replay_Warning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, &Replay_Round{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projectiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projectiles = append(m.Projectiles, &Replay_Projectile{})
+			if err := m.Projectiles[len(m.Projectiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Footer == nil {
+				m.Footer = &Replay_Footer{}
+			}
+			if err := m.Footer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TickRate = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRate", wireType)
+			}
+			m.SnapshotRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSampleRate", wireType)
+			}
+			m.PositionSampleRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionSampleRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackTime", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PlaybackTime = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackTicks", wireType)
+			}
+			m.PlaybackTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlaybackTicks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaybackFrames", wireType)
+			}
+			m.PlaybackFrames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlaybackFrames |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkProtocol", wireType)
+			}
+			m.NetworkProtocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkProtocol |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinifierVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinifierVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectileSampleRate", wireType)
+			}
+			m.ProjectileSampleRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectileSampleRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Footer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Footer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Footer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, &Replay_Footer_Team{})
+			if err := m.Teams[len(m.Teams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, &Replay_Footer_Score{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Footer_Team) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Team: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Team: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Team
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplay
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Team(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sides = append(m.Sides, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplay
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthReplay
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReplay
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Sides) == 0 {
+					m.Sides = make([]Team, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Team
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplay
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Team(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sides = append(m.Sides, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sides", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Footer_Score) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Score: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Score: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terrorists", wireType)
+			}
+			m.Terrorists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Terrorists |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterTerrorists", wireType)
+			}
+			m.CounterTerrorists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterTerrorists |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			m.Team = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Team |= Team(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNpc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsNpc = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteamId64", wireType)
+			}
+			m.SteamId64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SteamId64 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			m.AccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClanTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClanTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitiveWins", wireType)
+			}
+			m.CompetitiveWins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompetitiveWins |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosshairCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrosshairCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTick", wireType)
+			}
+			m.JoinTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveTick", wireType)
+			}
+			m.LeaveTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaveTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityUpdates = append(m.EntityUpdates, &Replay_Snapshot_EntityUpdate{})
+			if err := m.EntityUpdates[len(m.EntityUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delta = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplay
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemovedEntityIds = append(m.RemovedEntityIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplay
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthReplay
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReplay
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemovedEntityIds) == 0 {
+					m.RemovedEntityIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplay
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemovedEntityIds = append(m.RemovedEntityIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedEntityIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bomb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bomb == nil {
+				m.Bomb = &Replay_Snapshot_Bomb{}
+			}
+			if err := m.Bomb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infernos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infernos = append(m.Infernos, &Replay_Snapshot_Inferno{})
+			if err := m.Infernos[len(m.Infernos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Replay_Snapshot_Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostages = append(m.Hostages, &Replay_Snapshot_Hostage{})
+			if err := m.Hostages[len(m.Hostages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityEquipment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityEquipment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmoReserve", wireType)
+			}
+			m.AmmoReserve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmmoReserve |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmoInMagazine", wireType)
+			}
+			m.AmmoInMagazine = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmmoInMagazine |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Snapshot_EntityEconomy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityEconomy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityEconomy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
+			}
+			m.Money = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Money |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquipmentValue", wireType)
+			}
+			m.EquipmentValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EquipmentValue |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneySpentThisRound", wireType)
+			}
+			m.MoneySpentThisRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoneySpentThisRound |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMoney", wireType)
+			}
+			m.StartMoney = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMoney |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Snapshot_EntityStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kills", wireType)
+			}
+			m.Kills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kills |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deaths", wireType)
+			}
+			m.Deaths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deaths |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assists", wireType)
+			}
+			m.Assists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Assists |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mvps", wireType)
+			}
+			m.Mvps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mvps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
+			}
+			m.Ping = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ping |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			m.EntityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &Point{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AngleX", wireType)
+			}
+			m.AngleX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AngleX |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hp", wireType)
+			}
+			m.Hp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Armor", wireType)
+			}
+			m.Armor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Armor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashDuration", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.FlashDuration = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			m.Team = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Team |= Team(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNpc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsNpc = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AngleY", wireType)
+			}
+			m.AngleY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AngleY |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasHelmet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasHelmet = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasDefuseKit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasDefuseKit = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equipment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equipment = append(m.Equipment, &Replay_Snapshot_EntityEquipment{})
+			if err := m.Equipment[len(m.Equipment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			m.ChangedFields = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangedFields |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Economy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Economy == nil {
+				m.Economy = &Replay_Snapshot_EntityEconomy{}
+			}
+			if err := m.Economy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &Replay_Snapshot_EntityStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Velocity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Velocity == nil {
+				m.Velocity = &Point{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Point: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Point: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Velocity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDucking", wireType)
 			}
-			m.X = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1767,16 +7465,17 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.X |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.IsDucking = bool(v != 0)
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWalking", wireType)
 			}
-			m.Y = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1786,16 +7485,17 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Y |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IsWalking = bool(v != 0)
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Z", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAirborne", wireType)
 			}
-			m.Z = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1805,7 +7505,126 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Z |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAirborne = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsScoped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsScoped = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefusing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefusing = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPlanting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPlanting = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReloading", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReloading = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWeapon", wireType)
+			}
+			m.ActiveWeapon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveWeapon |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWeaponIndex", wireType)
+			}
+			m.ActiveWeaponIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveWeaponIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1816,10 +7635,7 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -1834,7 +7650,7 @@ func (m *Point) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay) Unmarshal(dAtA []byte) error {
+func (m *Replay_Snapshot_Bomb) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,17 +7673,17 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Replay: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bomb: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Replay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bomb: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarrierId", wireType)
 			}
-			var msglen int
+			m.CarrierId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1877,31 +7693,14 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CarrierId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplay
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &Replay_Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1928,16 +7727,38 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entities = append(m.Entities, &Replay_Entity{})
-			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Position == nil {
+				m.Position = &Point{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPlanted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPlanted = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Site", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1947,31 +7768,40 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplay
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshots = append(m.Snapshots, &Replay_Snapshot{})
-			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Site = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToExplosion", wireType)
 			}
-			var msglen int
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.TimeToExplosion = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefuserId", wireType)
+			}
+			m.DefuserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -1981,36 +7811,29 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DefuserId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplay
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefuseProgress", wireType)
 			}
-			m.Ticks = append(m.Ticks, &Replay_Tick{})
-			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DefuseProgress = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2025,7 +7848,7 @@ func (m *Replay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Header) Unmarshal(dAtA []byte) error {
+func (m *Replay_Snapshot_Inferno) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2048,17 +7871,17 @@ func (m *Replay_Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inferno: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inferno: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2068,40 +7891,35 @@ func (m *Replay_Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplay
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Map = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrowerId", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.ThrowerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrowerId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.TickRate = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fires", wireType)
 			}
-			m.SnapshotRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2111,21 +7929,33 @@ func (m *Replay_Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotRate |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fires = append(m.Fires, &Point{})
+			if err := m.Fires[len(m.Fires)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2140,7 +7970,7 @@ func (m *Replay_Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
+func (m *Replay_Snapshot_Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2163,10 +7993,10 @@ func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2189,10 +8019,10 @@ func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2202,29 +8032,16 @@ func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplay
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.Team = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2234,41 +8051,35 @@ func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Team |= Team(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsNpc", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
 			}
-			m.IsNpc = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Point{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2283,7 +8094,7 @@ func (m *Replay_Entity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
+func (m *Replay_Snapshot_Hostage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,17 +8117,17 @@ func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: Hostage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Hostage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Tick = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2326,14 +8137,14 @@ func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tick |= int32(b&0x7F) << shift
+				m.Id |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2360,21 +8171,77 @@ func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EntityUpdates = append(m.EntityUpdates, &Replay_Snapshot_EntityUpdate{})
-			if err := m.EntityUpdates[len(m.EntityUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Position == nil {
+				m.Position = &Point{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Replay_Snapshot_Hostage_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarrierId", wireType)
+			}
+			m.CarrierId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CarrierId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hp", wireType)
+			}
+			m.Hp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2389,7 +8256,7 @@ func (m *Replay_Snapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
+func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2412,17 +8279,17 @@ func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntityEquipment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntityEquipment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nr", wireType)
 			}
-			m.Type = 0
+			m.Nr = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2432,16 +8299,16 @@ func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				m.Nr |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmoReserve", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			m.AmmoReserve = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2451,40 +8318,33 @@ func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AmmoReserve |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmmoInMagazine", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthReplay
 			}
-			m.AmmoInMagazine = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AmmoInMagazine |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Replay_Tick_Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2499,7 +8359,7 @@ func (m *Replay_Snapshot_EntityEquipment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
+func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2522,17 +8382,17 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EntityUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EntityUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.EntityId = 0
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2542,14 +8402,14 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EntityId |= int32(b&0x7F) << shift
+				m.Kind |= Replay_Tick_Event_Kind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2576,103 +8436,66 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, &Point{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, &Replay_Tick_Event_Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AngleX", wireType)
-			}
-			m.AngleX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AngleX |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hp", wireType)
-			}
-			m.Hp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Hp |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplay(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Armor", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplay
 			}
-			m.Armor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Armor |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlashDuration", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplay
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.FlashDuration = float32(math.Float32frombits(v))
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
-			}
-			m.Team = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Team |= Team(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsNpc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2682,17 +8505,16 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Kind |= Replay_Tick_Event_Attribute_Kind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsNpc = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AngleY", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
 			}
-			m.AngleY = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2702,56 +8524,40 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AngleY |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasHelmet", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplay
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplay
 			}
-			m.HasHelmet = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasDefuseKit", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowReplay
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.StringValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberValue", wireType)
 			}
-			m.HasDefuseKit = bool(v != 0)
-		case 12:
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NumberValue = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equipment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2761,25 +8567,23 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplay
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Equipment = append(m.Equipment, &Replay_Snapshot_EntityEquipment{})
-			if err := m.Equipment[len(m.Equipment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CustomName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2787,10 +8591,7 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2805,7 +8606,7 @@ func (m *Replay_Snapshot_EntityUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
+func (m *Replay_Warning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2828,17 +8629,36 @@ func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+			return fmt.Errorf("proto: Warning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Warning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Nr = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2848,16 +8668,16 @@ func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nr |= int32(b&0x7F) << shift
+				m.Type |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2867,25 +8687,23 @@ func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthReplay
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &Replay_Tick_Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2893,10 +8711,7 @@ func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -2911,7 +8726,7 @@ func (m *Replay_Tick) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
+func (m *Replay_Round) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2934,17 +8749,17 @@ func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Round: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Round: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nr", wireType)
 			}
-			m.Kind = 0
+			m.Nr = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2954,16 +8769,16 @@ func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= Replay_Tick_Event_Kind(b&0x7F) << shift
+				m.Nr |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
 			}
-			var msglen int
+			m.StartTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -2973,36 +8788,151 @@ func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTick |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthReplay
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTimeEndTick", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
+			m.FreezeTimeEndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreezeTimeEndTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTick", wireType)
 			}
-			m.Attributes = append(m.Attributes, &Replay_Tick_Event_Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			m.Winner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Winner |= Team(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreTerrorists", wireType)
+			}
+			m.ScoreTerrorists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreTerrorists |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreCounterTerrorists", wireType)
+			}
+			m.ScoreCounterTerrorists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreCounterTerrorists |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BombOutcome", wireType)
+			}
+			m.BombOutcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BombOutcome |= Replay_Round_BombOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplay(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -3017,7 +8947,7 @@ func (m *Replay_Tick_Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
+func (m *Replay_Projectile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3040,17 +8970,17 @@ func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: Projectile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Projectile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Kind = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -3060,16 +8990,16 @@ func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= Replay_Tick_Event_Attribute_Kind(b&0x7F) << shift
+				m.Id |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrowerId", wireType)
 			}
-			var stringLen uint64
+			m.ThrowerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -3079,40 +9009,73 @@ func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ThrowerId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthReplay
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthReplay
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrowTick", wireType)
 			}
-			m.StringValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberValue", wireType)
+			m.ThrowTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrowTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetonationTick", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NumberValue = float64(math.Float64frombits(v))
-		case 4:
+			m.DetonationTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetonationTick |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trajectory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowReplay
@@ -3122,23 +9085,25 @@ func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthReplay
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CustomName = string(dAtA[iNdEx:postIndex])
+			m.Trajectory = append(m.Trajectory, &Point{})
+			if err := m.Trajectory[len(m.Trajectory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3146,10 +9111,7 @@ func (m *Replay_Tick_Event_Attribute) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthReplay
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthReplay
 			}
 			if (iNdEx + skippy) > l {
@@ -12,14 +12,14 @@ import (
 // MarshalReplay serializes a Replay as protobuf to an io.Writer
 func MarshalReplay(r rep.Replay, w io.Writer) error {
 	pbReplay := gen.Replay{
-		Entities: mapToEntities(r.Entities),
-		Header: &gen.Replay_Header{
-			Map:          r.Header.MapName,
-			SnapshotRate: int32(r.Header.SnapshotRate),
-			TickRate:     r.Header.TickRate,
-		},
-		Snapshots: mapToSnapshots(r.Snapshots),
-		Ticks:     mapToTicks(r.Ticks),
+		Entities:    mapToEntities(r.Entities),
+		Header:      mapToHeader(r.Header),
+		Snapshots:   mapToSnapshots(r.Snapshots),
+		Ticks:       mapToTicks(r.Ticks),
+		Warnings:    mapToWarnings(r.Warnings),
+		Rounds:      mapToRounds(r.Rounds),
+		Projectiles: mapToProjectiles(r.Projectiles),
+		Footer:      mapToFooter(r.Footer),
 	}
 
 	data, err := pbReplay.Marshal()
@@ -30,19 +30,92 @@ func MarshalReplay(r rep.Replay, w io.Writer) error {
 	return err
 }
 
+func mapToHeader(h rep.Header) *gen.Replay_Header {
+	return &gen.Replay_Header{
+		Map:                  h.MapName,
+		SnapshotRate:         int32(h.SnapshotRate),
+		TickRate:             h.TickRate,
+		PositionSampleRate:   int32(h.PositionSampleRate),
+		ProjectileSampleRate: int32(h.ProjectileSampleRate),
+		ServerName:           h.ServerName,
+		ClientName:           h.ClientName,
+		PlaybackTime:         h.PlaybackTime,
+		PlaybackTicks:        int32(h.PlaybackTicks),
+		PlaybackFrames:       int32(h.PlaybackFrames),
+		NetworkProtocol:      int32(h.NetworkProtocol),
+		Filestamp:            h.Filestamp,
+		MinifierVersion:      h.MinifierVersion,
+	}
+}
+
+func mapToFooter(f rep.Footer) *gen.Replay_Footer {
+	return &gen.Replay_Footer{
+		Teams:  mapToTeams(f.Teams),
+		Scores: mapToScores(f.Scores),
+		Sha256: f.SHA256,
+	}
+}
+
+func mapToTeams(teams []rep.Team) []*gen.Replay_Footer_Team {
+	result := make([]*gen.Replay_Footer_Team, 0, len(teams))
+	for _, t := range teams {
+		sides := make([]gen.Team, 0, len(t.Sides))
+		for _, side := range t.Sides {
+			sides = append(sides, mapToTeam(side))
+		}
+
+		result = append(result, &gen.Replay_Footer_Team{
+			ClanName: t.ClanName,
+			Flag:     t.Flag,
+			Sides:    sides,
+		})
+	}
+
+	return result
+}
+
+func mapToScores(scores []rep.Score) []*gen.Replay_Footer_Score {
+	result := make([]*gen.Replay_Footer_Score, 0, len(scores))
+	for _, s := range scores {
+		result = append(result, &gen.Replay_Footer_Score{
+			Tick:              int32(s.Tick),
+			Round:             int32(s.Round),
+			Terrorists:        int32(s.Terrorists),
+			CounterTerrorists: int32(s.CounterTerrorists),
+		})
+	}
+
+	return result
+}
+
 func mapToEntities(entities []rep.Entity) []*gen.Replay_Entity {
 	result := make([]*gen.Replay_Entity, 0)
 	for _, e := range entities {
-		result = append(result, &gen.Replay_Entity{
-			Id:    int32(e.ID),
-			Team:  mapToTeam(e.Team),
-			Name:  e.Name,
-			IsNpc: e.IsNpc,
-		})
+		result = append(result, mapToEntity(e))
 	}
 	return result
 }
 
+func mapToEntity(e rep.Entity) *gen.Replay_Entity {
+	return &gen.Replay_Entity{
+		Id:    int32(e.ID),
+		Team:  mapToTeam(e.Team),
+		Name:  e.Name,
+		IsNpc: e.IsNpc,
+
+		SteamId64:       e.SteamID64,
+		AccountId:       e.AccountID,
+		ClanTag:         e.ClanTag,
+		Rank:            int32(e.Rank),
+		CompetitiveWins: int32(e.CompetitiveWins),
+		CrosshairCode:   e.CrosshairCode,
+
+		Tick:      int32(e.Tick),
+		JoinTick:  int32(e.JoinTick),
+		LeaveTick: int32(e.LeaveTick),
+	}
+}
+
 func mapToTeam(team int) gen.Team {
 	var result gen.Team
 	switch common.Team(team) {
@@ -61,9 +134,73 @@ func mapToTeam(team int) gen.Team {
 func mapToSnapshots(snaps []rep.Snapshot) []*gen.Replay_Snapshot {
 	result := make([]*gen.Replay_Snapshot, 0)
 	for _, s := range snaps {
-		result = append(result, &gen.Replay_Snapshot{
-			Tick:          int32(s.Tick),
-			EntityUpdates: mapToEntityUpdates(s.EntityUpdates),
+		result = append(result, mapToSnapshot(s))
+	}
+	return result
+}
+
+func mapToSnapshot(s rep.Snapshot) *gen.Replay_Snapshot {
+	return &gen.Replay_Snapshot{
+		Tick:             int32(s.Tick),
+		Delta:            s.Delta,
+		EntityUpdates:    mapToEntityUpdates(s.EntityUpdates),
+		RemovedEntityIds: mapToInt32s(s.RemovedEntityIDs),
+		Bomb:             mapToBomb(s.Bomb),
+		Infernos:         mapToInfernos(s.Infernos),
+		Items:            mapToItems(s.Items),
+		Hostages:         mapToHostages(s.Hostages),
+	}
+}
+
+func mapToBomb(b *rep.Bomb) *gen.Replay_Snapshot_Bomb {
+	if b == nil {
+		return nil
+	}
+
+	return &gen.Replay_Snapshot_Bomb{
+		CarrierId:       int32(b.CarrierID),
+		Position:        mapToPoint(b.Position),
+		IsPlanted:       b.IsPlanted,
+		Site:            b.Site,
+		TimeToExplosion: b.TimeToExplosion,
+		DefuserId:       int32(b.DefuserID),
+		DefuseProgress:  b.DefuseProgress,
+	}
+}
+
+func mapToInfernos(infernos []rep.Inferno) []*gen.Replay_Snapshot_Inferno {
+	result := make([]*gen.Replay_Snapshot_Inferno, 0)
+	for _, inf := range infernos {
+		result = append(result, &gen.Replay_Snapshot_Inferno{
+			Id:        int32(inf.ID),
+			ThrowerId: int32(inf.ThrowerID),
+			Fires:     mapToPositions(inf.Fires),
+		})
+	}
+	return result
+}
+
+func mapToItems(items []rep.Item) []*gen.Replay_Snapshot_Item {
+	result := make([]*gen.Replay_Snapshot_Item, 0)
+	for _, item := range items {
+		result = append(result, &gen.Replay_Snapshot_Item{
+			Id:       int32(item.ID),
+			Type:     int32(item.Type),
+			Position: mapToPosition(item.Position),
+		})
+	}
+	return result
+}
+
+func mapToHostages(hostages []rep.Hostage) []*gen.Replay_Snapshot_Hostage {
+	result := make([]*gen.Replay_Snapshot_Hostage, 0)
+	for _, h := range hostages {
+		result = append(result, &gen.Replay_Snapshot_Hostage{
+			Id:        int32(h.ID),
+			Position:  mapToPosition(h.Position),
+			State:     gen.Replay_Snapshot_Hostage_State(h.State),
+			CarrierId: int32(h.CarrierID),
+			Hp:        int32(h.Hp),
 		})
 	}
 	return result
@@ -73,18 +210,31 @@ func mapToEntityUpdates(entityUpdates []rep.EntityUpdate) []*gen.Replay_Snapshot
 	result := make([]*gen.Replay_Snapshot_EntityUpdate, 0)
 	for _, u := range entityUpdates {
 		result = append(result, &gen.Replay_Snapshot_EntityUpdate{
-			AngleX:        int32(u.AngleX),
-			AngleY:        int32(u.AngleY),
-			Armor:         int32(u.Armor),
-			EntityId:      int32(u.EntityID),
-			FlashDuration: u.FlashDuration,
-			Hp:            int32(u.Hp),
-			Positions:     mapToPositions(u.Positions),
-			IsNpc:         u.IsNpc,
-			Team:          mapToTeam(u.Team),
-			HasHelmet:     u.HasHelmet,
-			HasDefuseKit:  u.HasDefuseKit,
-			Equipment:     mapToEquipment(u.Equipment),
+			AngleX:            int32(u.AngleX),
+			AngleY:            int32(u.AngleY),
+			Armor:             int32(u.Armor),
+			EntityId:          int32(u.EntityID),
+			FlashDuration:     u.FlashDuration,
+			Hp:                int32(u.Hp),
+			Positions:         mapToPositions(u.Positions),
+			IsNpc:             u.IsNpc,
+			Team:              mapToTeam(u.Team),
+			HasHelmet:         u.HasHelmet,
+			HasDefuseKit:      u.HasDefuseKit,
+			Equipment:         mapToEquipment(u.Equipment),
+			ChangedFields:     u.ChangedFields,
+			Economy:           mapToEconomy(u.Economy),
+			Stats:             mapToStats(u.Stats),
+			ActiveWeapon:      int32(u.ActiveWeapon),
+			ActiveWeaponIndex: int32(u.ActiveWeaponIndex),
+			Velocity:          mapToPoint(u.Velocity),
+			IsDucking:         u.IsDucking,
+			IsWalking:         u.IsWalking,
+			IsAirborne:        u.IsAirborne,
+			IsScoped:          u.IsScoped,
+			IsDefusing:        u.IsDefusing,
+			IsPlanting:        u.IsPlanting,
+			IsReloading:       u.IsReloading,
 		})
 	}
 
@@ -104,6 +254,47 @@ func mapToEquipment(equipment []rep.EntityEquipment) []*gen.Replay_Snapshot_Enti
 	return result
 }
 
+func mapToEconomy(eco *rep.EntityEconomy) *gen.Replay_Snapshot_EntityEconomy {
+	if eco == nil {
+		return nil
+	}
+
+	return &gen.Replay_Snapshot_EntityEconomy{
+		Money:               int32(eco.Money),
+		EquipmentValue:      int32(eco.EquipmentValue),
+		MoneySpentThisRound: int32(eco.MoneySpentThisRound),
+		StartMoney:          int32(eco.StartMoney),
+	}
+}
+
+func mapToStats(stats *rep.EntityStats) *gen.Replay_Snapshot_EntityStats {
+	if stats == nil {
+		return nil
+	}
+
+	return &gen.Replay_Snapshot_EntityStats{
+		Kills:   int32(stats.Kills),
+		Deaths:  int32(stats.Deaths),
+		Assists: int32(stats.Assists),
+		Mvps:    int32(stats.MVPs),
+		Score:   int32(stats.Score),
+		Ping:    int32(stats.Ping),
+	}
+}
+
+func mapToInt32s(ints []int) []int32 {
+	if ints == nil {
+		return nil
+	}
+
+	result := make([]int32, len(ints))
+	for i, v := range ints {
+		result[i] = int32(v)
+	}
+
+	return result
+}
+
 func mapToPositions(positions []rep.Point) []*gen.Point {
 	result := make([]*gen.Point, 0)
 	for _, p := range positions {
@@ -120,17 +311,29 @@ func mapToPosition(p rep.Point) *gen.Point {
 	}
 }
 
+func mapToPoint(p *rep.Point) *gen.Point {
+	if p == nil {
+		return nil
+	}
+
+	return mapToPosition(*p)
+}
+
 func mapToTicks(ticks []rep.Tick) []*gen.Replay_Tick {
 	result := make([]*gen.Replay_Tick, 0)
 	for _, t := range ticks {
-		result = append(result, &gen.Replay_Tick{
-			Nr:     int32(t.Nr),
-			Events: mapToEvents(t.Events),
-		})
+		result = append(result, mapToTick(t))
 	}
 	return result
 }
 
+func mapToTick(t rep.Tick) *gen.Replay_Tick {
+	return &gen.Replay_Tick{
+		Nr:     int32(t.Nr),
+		Events: mapToEvents(t.Events),
+	}
+}
+
 func mapToEvents(events []rep.Event) []*gen.Replay_Tick_Event {
 	result := make([]*gen.Replay_Tick_Event, 0)
 	for _, e := range events {
@@ -187,3 +390,73 @@ func mapToEventKind(name string) gen.Replay_Tick_Event_Kind {
 	}
 	return kind.(gen.Replay_Tick_Event_Kind)
 }
+
+func mapToWarnings(warnings []rep.Warning) []*gen.Replay_Warning {
+	result := make([]*gen.Replay_Warning, 0)
+	for _, w := range warnings {
+		result = append(result, mapToWarning(w))
+	}
+	return result
+}
+
+func mapToWarning(w rep.Warning) *gen.Replay_Warning {
+	return &gen.Replay_Warning{
+		Tick:    int32(w.Tick),
+		Type:    int32(w.Type),
+		Message: w.Message,
+	}
+}
+
+func mapToRounds(rounds []rep.Round) []*gen.Replay_Round {
+	result := make([]*gen.Replay_Round, 0)
+	for _, r := range rounds {
+		result = append(result, mapToRound(r))
+	}
+	return result
+}
+
+func mapToRound(r rep.Round) *gen.Replay_Round {
+	return &gen.Replay_Round{
+		Nr:                     int32(r.Nr),
+		StartTick:              int32(r.StartTick),
+		FreezeTimeEndTick:      int32(r.FreezeTimeEndTick),
+		EndTick:                int32(r.EndTick),
+		Winner:                 mapToTeam(r.Winner),
+		Reason:                 int32(r.Reason),
+		ScoreTerrorists:        int32(r.ScoreTerrorists),
+		ScoreCounterTerrorists: int32(r.ScoreCounterTerrorists),
+		BombOutcome:            mapToBombOutcome(r.BombOutcome),
+	}
+}
+
+func mapToBombOutcome(outcome string) gen.Replay_Round_BombOutcome {
+	switch outcome {
+	case rep.BombOutcomePlanted:
+		return gen.Replay_Round_PLANTED
+	case rep.BombOutcomeDefused:
+		return gen.Replay_Round_DEFUSED
+	case rep.BombOutcomeExploded:
+		return gen.Replay_Round_EXPLODED
+	default:
+		return gen.Replay_Round_NONE
+	}
+}
+
+func mapToProjectiles(projectiles []rep.Projectile) []*gen.Replay_Projectile {
+	result := make([]*gen.Replay_Projectile, 0)
+	for _, p := range projectiles {
+		result = append(result, mapToProjectile(p))
+	}
+	return result
+}
+
+func mapToProjectile(p rep.Projectile) *gen.Replay_Projectile {
+	return &gen.Replay_Projectile{
+		Id:             int32(p.ID),
+		ThrowerId:      int32(p.ThrowerID),
+		Type:           int32(p.Type),
+		ThrowTick:      int32(p.ThrowTick),
+		DetonationTick: int32(p.DetonationTick),
+		Trajectory:     mapToPositions(p.Trajectory),
+	}
+}
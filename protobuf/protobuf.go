@@ -26,6 +26,24 @@ func init() {
 	attributeKindMap.Insert(rep.AttrKindText, gen.Replay_Tick_Event_Attribute_TEXT)
 	attributeKindMap.Insert(attrKindEventName, gen.Replay_Tick_Event_Attribute_EVENT_NAME)
 	attributeKindMap.Insert(rep.AttrKindThrowerID, gen.Replay_Tick_Event_Attribute_THROWER_ENTITY_ID)
+	attributeKindMap.Insert(rep.AttrKindSite, gen.Replay_Tick_Event_Attribute_SITE)
+	attributeKindMap.Insert(rep.AttrKindProjectileID, gen.Replay_Tick_Event_Attribute_PROJECTILE_ID)
+	attributeKindMap.Insert(rep.AttrKindItemID, gen.Replay_Tick_Event_Attribute_ITEM_ID)
+	attributeKindMap.Insert(rep.AttrKindHostageID, gen.Replay_Tick_Event_Attribute_HOSTAGE_ID)
+	attributeKindMap.Insert(rep.AttrKindWeapon, gen.Replay_Tick_Event_Attribute_WEAPON)
+	attributeKindMap.Insert(rep.AttrKindIsHeadshot, gen.Replay_Tick_Event_Attribute_IS_HEADSHOT)
+	attributeKindMap.Insert(rep.AttrKindPenetratedObjects, gen.Replay_Tick_Event_Attribute_PENETRATED_OBJECTS)
+	attributeKindMap.Insert(rep.AttrKindIsNoScope, gen.Replay_Tick_Event_Attribute_IS_NO_SCOPE)
+	attributeKindMap.Insert(rep.AttrKindIsThroughSmoke, gen.Replay_Tick_Event_Attribute_IS_THROUGH_SMOKE)
+	attributeKindMap.Insert(rep.AttrKindIsAttackerBlind, gen.Replay_Tick_Event_Attribute_IS_ATTACKER_BLIND)
+	attributeKindMap.Insert(rep.AttrKindIsAssistedFlash, gen.Replay_Tick_Event_Attribute_IS_ASSISTED_FLASH)
+	attributeKindMap.Insert(rep.AttrKindDistance, gen.Replay_Tick_Event_Attribute_DISTANCE)
+	attributeKindMap.Insert(rep.AttrKindAttacker, gen.Replay_Tick_Event_Attribute_ATTACKER)
+	attributeKindMap.Insert(rep.AttrKindHealthDamage, gen.Replay_Tick_Event_Attribute_HEALTH_DAMAGE)
+	attributeKindMap.Insert(rep.AttrKindArmorDamage, gen.Replay_Tick_Event_Attribute_ARMOR_DAMAGE)
+	attributeKindMap.Insert(rep.AttrKindHealth, gen.Replay_Tick_Event_Attribute_HEALTH)
+	attributeKindMap.Insert(rep.AttrKindArmor, gen.Replay_Tick_Event_Attribute_ARMOR)
+	attributeKindMap.Insert(rep.AttrKindHitGroup, gen.Replay_Tick_Event_Attribute_HIT_GROUP)
 
 	eventKindMap.Insert(rep.EventJump, gen.Replay_Tick_Event_JUMP)
 	eventKindMap.Insert(rep.EventFire, gen.Replay_Tick_Event_FIRE)
@@ -46,4 +64,21 @@ func init() {
 	eventKindMap.Insert(rep.EventFireGrenadeExpired, gen.Replay_Tick_Event_FIRE_GRENADE_EXPIRED)
 	eventKindMap.Insert(rep.EventHEGrenadeExplosion, gen.Replay_Tick_Event_HE_GRENADE_EXPLOSION)
 	eventKindMap.Insert(rep.EventFlashExplosion, gen.Replay_Tick_Event_FLASH_EXPLOSION)
+	eventKindMap.Insert(rep.EventWeaponSwitch, gen.Replay_Tick_Event_WEAPON_SWITCH)
+	eventKindMap.Insert(rep.EventBombPickup, gen.Replay_Tick_Event_BOMB_PICKUP)
+	eventKindMap.Insert(rep.EventBombDropped, gen.Replay_Tick_Event_BOMB_DROPPED)
+	eventKindMap.Insert(rep.EventBombPlantBegin, gen.Replay_Tick_Event_BOMB_PLANT_BEGIN)
+	eventKindMap.Insert(rep.EventBombPlantAborted, gen.Replay_Tick_Event_BOMB_PLANT_ABORTED)
+	eventKindMap.Insert(rep.EventBombPlanted, gen.Replay_Tick_Event_BOMB_PLANTED)
+	eventKindMap.Insert(rep.EventBombDefuseStart, gen.Replay_Tick_Event_BOMB_DEFUSE_START)
+	eventKindMap.Insert(rep.EventBombDefuseAborted, gen.Replay_Tick_Event_BOMB_DEFUSE_ABORTED)
+	eventKindMap.Insert(rep.EventBombDefused, gen.Replay_Tick_Event_BOMB_DEFUSED)
+	eventKindMap.Insert(rep.EventBombExploded, gen.Replay_Tick_Event_BOMB_EXPLODED)
+	eventKindMap.Insert(rep.EventGrenadeThrown, gen.Replay_Tick_Event_GRENADE_THROWN)
+	eventKindMap.Insert(rep.EventGrenadeLanded, gen.Replay_Tick_Event_GRENADE_LANDED)
+	eventKindMap.Insert(rep.EventItemPickup, gen.Replay_Tick_Event_ITEM_PICKUP)
+	eventKindMap.Insert(rep.EventItemDrop, gen.Replay_Tick_Event_ITEM_DROP)
+	eventKindMap.Insert(rep.EventHostagePickup, gen.Replay_Tick_Event_HOSTAGE_PICKUP)
+	eventKindMap.Insert(rep.EventHostageRescued, gen.Replay_Tick_Event_HOSTAGE_RESCUED)
+	eventKindMap.Insert(rep.EventHostageKilled, gen.Replay_Tick_Event_HOSTAGE_KILLED)
 }
@@ -0,0 +1,95 @@
+package protobuf
+
+import (
+	io "io"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// Field numbers of gen.Replay, see replay.proto
+const (
+	fieldHeader      = 1
+	fieldEntities    = 2
+	fieldSnapshots   = 3
+	fieldTicks       = 4
+	fieldWarnings    = 5
+	fieldRounds      = 6
+	fieldProjectiles = 7
+	fieldFooter      = 8
+
+	wireTypeBytes = 2
+)
+
+// StreamWriter writes replays as protobuf while they are being minified.
+// Every part of the replay is written as a separate field of the Replay message,
+// so the result can be read with UnmarshalReplay like any other protobuf replay.
+// Implements csminify.ReplaySink.
+type StreamWriter struct {
+	w io.Writer
+}
+
+// NewStreamWriter returns a StreamWriter that writes to w.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+type message interface {
+	Marshal() ([]byte, error)
+}
+
+// Header writes the header to the stream.
+func (sw *StreamWriter) Header(h rep.Header) error {
+	return sw.writeField(fieldHeader, mapToHeader(h))
+}
+
+// Entity writes an entity to the stream.
+func (sw *StreamWriter) Entity(e rep.Entity) error {
+	return sw.writeField(fieldEntities, mapToEntity(e))
+}
+
+// Snapshot writes a snapshot to the stream.
+func (sw *StreamWriter) Snapshot(s rep.Snapshot) error {
+	return sw.writeField(fieldSnapshots, mapToSnapshot(s))
+}
+
+// Tick writes a tick to the stream.
+func (sw *StreamWriter) Tick(t rep.Tick) error {
+	return sw.writeField(fieldTicks, mapToTick(t))
+}
+
+// Warning writes a parser warning to the stream.
+func (sw *StreamWriter) Warning(w rep.Warning) error {
+	return sw.writeField(fieldWarnings, mapToWarning(w))
+}
+
+// Round writes a round to the stream.
+func (sw *StreamWriter) Round(r rep.Round) error {
+	return sw.writeField(fieldRounds, mapToRound(r))
+}
+
+// Projectile writes a grenade projectile to the stream.
+func (sw *StreamWriter) Projectile(p rep.Projectile) error {
+	return sw.writeField(fieldProjectiles, mapToProjectile(p))
+}
+
+// Footer writes the footer to the stream.
+func (sw *StreamWriter) Footer(f rep.Footer) error {
+	return sw.writeField(fieldFooter, mapToFooter(f))
+}
+
+func (sw *StreamWriter) writeField(field uint64, msg message) error {
+	data, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+
+	buf := proto.EncodeVarint(field<<3 | wireTypeBytes)
+	buf = append(buf, proto.EncodeVarint(uint64(len(data)))...)
+	buf = append(buf, data...)
+
+	_, err = sw.w.Write(buf)
+
+	return err
+}
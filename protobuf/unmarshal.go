@@ -28,18 +28,84 @@ func UnmarshalReplay(r io.Reader, replay *rep.Replay) error {
 	replay.Entities = mapFromEntities(pbReplay.Entities)
 	replay.Snapshots = mapFromSnapshots(pbReplay.Snapshots)
 	replay.Ticks = mapFromTicks(pbReplay.Ticks)
+	replay.Warnings = mapFromWarnings(pbReplay.Warnings)
+	replay.Rounds = mapFromRounds(pbReplay.Rounds)
+	replay.Projectiles = mapFromProjectiles(pbReplay.Projectiles)
+	replay.Footer = mapFromFooter(pbReplay.Footer)
 
 	return nil
 }
 
 func mapFromHeader(header *gen.Replay_Header) rep.Header {
 	return rep.Header{
-		MapName:      header.Map,
-		SnapshotRate: int(header.SnapshotRate),
-		TickRate:     header.TickRate,
+		MapName:              header.Map,
+		SnapshotRate:         int(header.SnapshotRate),
+		TickRate:             header.TickRate,
+		PositionSampleRate:   int(header.PositionSampleRate),
+		ProjectileSampleRate: int(header.ProjectileSampleRate),
+		ServerName:           header.ServerName,
+		ClientName:           header.ClientName,
+		PlaybackTime:         header.PlaybackTime,
+		PlaybackTicks:        int(header.PlaybackTicks),
+		PlaybackFrames:       int(header.PlaybackFrames),
+		NetworkProtocol:      int(header.NetworkProtocol),
+		Filestamp:            header.Filestamp,
+		MinifierVersion:      header.MinifierVersion,
 	}
 }
 
+func mapFromFooter(footer *gen.Replay_Footer) rep.Footer {
+	if footer == nil {
+		return rep.Footer{}
+	}
+
+	return rep.Footer{
+		Teams:  mapFromTeams(footer.Teams),
+		Scores: mapFromScores(footer.Scores),
+		SHA256: footer.Sha256,
+	}
+}
+
+func mapFromTeams(teams []*gen.Replay_Footer_Team) []rep.Team {
+	if teams == nil {
+		return nil
+	}
+
+	result := make([]rep.Team, len(teams))
+	for i, t := range teams {
+		var sides []int
+		for _, side := range t.Sides {
+			sides = append(sides, mapFromTeam(side))
+		}
+
+		result[i] = rep.Team{
+			ClanName: t.ClanName,
+			Flag:     t.Flag,
+			Sides:    sides,
+		}
+	}
+
+	return result
+}
+
+func mapFromScores(scores []*gen.Replay_Footer_Score) []rep.Score {
+	if scores == nil {
+		return nil
+	}
+
+	result := make([]rep.Score, len(scores))
+	for i, s := range scores {
+		result[i] = rep.Score{
+			Tick:              int(s.Tick),
+			Round:             int(s.Round),
+			Terrorists:        int(s.Terrorists),
+			CounterTerrorists: int(s.CounterTerrorists),
+		}
+	}
+
+	return result
+}
+
 func mapFromEntities(entities []*gen.Replay_Entity) []rep.Entity {
 	if entities == nil {
 		return nil
@@ -52,6 +118,17 @@ func mapFromEntities(entities []*gen.Replay_Entity) []rep.Entity {
 			Team:  mapFromTeam(e.Team),
 			Name:  e.Name,
 			IsNpc: e.IsNpc,
+
+			SteamID64:       e.SteamId64,
+			AccountID:       e.AccountId,
+			ClanTag:         e.ClanTag,
+			Rank:            int(e.Rank),
+			CompetitiveWins: int(e.CompetitiveWins),
+			CrosshairCode:   e.CrosshairCode,
+
+			Tick:      int(e.Tick),
+			JoinTick:  int(e.JoinTick),
+			LeaveTick: int(e.LeaveTick),
 		}
 	}
 
@@ -81,8 +158,14 @@ func mapFromSnapshots(snaps []*gen.Replay_Snapshot) []rep.Snapshot {
 	result := make([]rep.Snapshot, len(snaps))
 	for i, s := range snaps {
 		result[i] = rep.Snapshot{
-			Tick:          int(s.Tick),
-			EntityUpdates: mapFromEntityUpdates(s.EntityUpdates),
+			Tick:             int(s.Tick),
+			Delta:            s.Delta,
+			EntityUpdates:    mapFromEntityUpdates(s.EntityUpdates),
+			RemovedEntityIDs: mapFromInt32s(s.RemovedEntityIds),
+			Bomb:             mapFromBomb(s.Bomb),
+			Infernos:         mapFromInfernos(s.Infernos),
+			Items:            mapFromItems(s.Items),
+			Hostages:         mapFromHostages(s.Hostages),
 		}
 	}
 
@@ -97,18 +180,31 @@ func mapFromEntityUpdates(entityUpdates []*gen.Replay_Snapshot_EntityUpdate) []r
 	result := make([]rep.EntityUpdate, len(entityUpdates))
 	for i, u := range entityUpdates {
 		result[i] = rep.EntityUpdate{
-			AngleX:        int(u.AngleX),
-			AngleY:        int(u.AngleY),
-			Armor:         int(u.Armor),
-			EntityID:      int(u.EntityId),
-			FlashDuration: u.FlashDuration,
-			Hp:            int(u.Hp),
-			Positions:     mapFromPositions(u.Positions),
-			IsNpc:         u.IsNpc,
-			Team:          mapFromTeam(u.Team),
-			HasHelmet:     u.HasHelmet,
-			HasDefuseKit:  u.HasDefuseKit,
-			Equipment:     mapFromEquipment(u.Equipment),
+			AngleX:            int(u.AngleX),
+			AngleY:            int(u.AngleY),
+			Armor:             int(u.Armor),
+			EntityID:          int(u.EntityId),
+			FlashDuration:     u.FlashDuration,
+			Hp:                int(u.Hp),
+			Positions:         mapFromPositions(u.Positions),
+			IsNpc:             u.IsNpc,
+			Team:              mapFromTeam(u.Team),
+			HasHelmet:         u.HasHelmet,
+			HasDefuseKit:      u.HasDefuseKit,
+			Equipment:         mapFromEquipment(u.Equipment),
+			ChangedFields:     u.ChangedFields,
+			Economy:           mapFromEconomy(u.Economy),
+			Stats:             mapFromStats(u.Stats),
+			ActiveWeapon:      int(u.ActiveWeapon),
+			ActiveWeaponIndex: int(u.ActiveWeaponIndex),
+			Velocity:          mapFromPoint(u.Velocity),
+			IsDucking:         u.IsDucking,
+			IsWalking:         u.IsWalking,
+			IsAirborne:        u.IsAirborne,
+			IsScoped:          u.IsScoped,
+			IsDefusing:        u.IsDefusing,
+			IsPlanting:        u.IsPlanting,
+			IsReloading:       u.IsReloading,
 		}
 	}
 
@@ -132,6 +228,122 @@ func mapFromEquipment(equipment []*gen.Replay_Snapshot_EntityEquipment) []rep.En
 	return result
 }
 
+func mapFromBomb(b *gen.Replay_Snapshot_Bomb) *rep.Bomb {
+	if b == nil {
+		return nil
+	}
+
+	return &rep.Bomb{
+		CarrierID:       int(b.CarrierId),
+		Position:        mapFromPoint(b.Position),
+		IsPlanted:       b.IsPlanted,
+		Site:            b.Site,
+		TimeToExplosion: b.TimeToExplosion,
+		DefuserID:       int(b.DefuserId),
+		DefuseProgress:  b.DefuseProgress,
+	}
+}
+
+func mapFromInfernos(infernos []*gen.Replay_Snapshot_Inferno) []rep.Inferno {
+	if infernos == nil {
+		return nil
+	}
+
+	result := make([]rep.Inferno, len(infernos))
+	for i, inf := range infernos {
+		result[i] = rep.Inferno{
+			ID:        int(inf.Id),
+			ThrowerID: int(inf.ThrowerId),
+			Fires:     mapFromPositions(inf.Fires),
+		}
+	}
+
+	return result
+}
+
+func mapFromItems(items []*gen.Replay_Snapshot_Item) []rep.Item {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]rep.Item, len(items))
+	for i, item := range items {
+		result[i] = rep.Item{
+			ID:   int(item.Id),
+			Type: int(item.Type),
+		}
+
+		if item.Position != nil {
+			result[i].Position = mapFromPosition(item.Position)
+		}
+	}
+
+	return result
+}
+
+func mapFromHostages(hostages []*gen.Replay_Snapshot_Hostage) []rep.Hostage {
+	if hostages == nil {
+		return nil
+	}
+
+	result := make([]rep.Hostage, len(hostages))
+	for i, h := range hostages {
+		result[i] = rep.Hostage{
+			ID:        int(h.Id),
+			State:     int(h.State),
+			CarrierID: int(h.CarrierId),
+			Hp:        int(h.Hp),
+		}
+
+		if h.Position != nil {
+			result[i].Position = mapFromPosition(h.Position)
+		}
+	}
+
+	return result
+}
+
+func mapFromEconomy(eco *gen.Replay_Snapshot_EntityEconomy) *rep.EntityEconomy {
+	if eco == nil {
+		return nil
+	}
+
+	return &rep.EntityEconomy{
+		Money:               int(eco.Money),
+		EquipmentValue:      int(eco.EquipmentValue),
+		MoneySpentThisRound: int(eco.MoneySpentThisRound),
+		StartMoney:          int(eco.StartMoney),
+	}
+}
+
+func mapFromStats(stats *gen.Replay_Snapshot_EntityStats) *rep.EntityStats {
+	if stats == nil {
+		return nil
+	}
+
+	return &rep.EntityStats{
+		Kills:   int(stats.Kills),
+		Deaths:  int(stats.Deaths),
+		Assists: int(stats.Assists),
+		MVPs:    int(stats.Mvps),
+		Score:   int(stats.Score),
+		Ping:    int(stats.Ping),
+	}
+}
+
+func mapFromInt32s(ints []int32) []int {
+	if ints == nil {
+		return nil
+	}
+
+	result := make([]int, len(ints))
+	for i, v := range ints {
+		result[i] = int(v)
+	}
+
+	return result
+}
+
 func mapFromPositions(positions []*gen.Point) []rep.Point {
 	if positions == nil {
 		return nil
@@ -145,6 +357,16 @@ func mapFromPositions(positions []*gen.Point) []rep.Point {
 	return result
 }
 
+func mapFromPoint(p *gen.Point) *rep.Point {
+	if p == nil {
+		return nil
+	}
+
+	res := mapFromPosition(p)
+
+	return &res
+}
+
 func mapFromPosition(p *gen.Point) rep.Point {
 	return rep.Point{
 		X: int(p.X),
@@ -169,6 +391,79 @@ func mapFromTicks(ticks []*gen.Replay_Tick) []rep.Tick {
 	return result
 }
 
+func mapFromWarnings(warnings []*gen.Replay_Warning) []rep.Warning {
+	if warnings == nil {
+		return nil
+	}
+
+	result := make([]rep.Warning, len(warnings))
+	for i, w := range warnings {
+		result[i] = rep.Warning{
+			Tick:    int(w.Tick),
+			Type:    int(w.Type),
+			Message: w.Message,
+		}
+	}
+
+	return result
+}
+
+func mapFromRounds(rounds []*gen.Replay_Round) []rep.Round {
+	if rounds == nil {
+		return nil
+	}
+
+	result := make([]rep.Round, len(rounds))
+	for i, r := range rounds {
+		result[i] = rep.Round{
+			Nr:                     int(r.Nr),
+			StartTick:              int(r.StartTick),
+			FreezeTimeEndTick:      int(r.FreezeTimeEndTick),
+			EndTick:                int(r.EndTick),
+			Winner:                 mapFromTeam(r.Winner),
+			Reason:                 int(r.Reason),
+			ScoreTerrorists:        int(r.ScoreTerrorists),
+			ScoreCounterTerrorists: int(r.ScoreCounterTerrorists),
+			BombOutcome:            mapFromBombOutcome(r.BombOutcome),
+		}
+	}
+
+	return result
+}
+
+func mapFromBombOutcome(outcome gen.Replay_Round_BombOutcome) string {
+	switch outcome {
+	case gen.Replay_Round_PLANTED:
+		return rep.BombOutcomePlanted
+	case gen.Replay_Round_DEFUSED:
+		return rep.BombOutcomeDefused
+	case gen.Replay_Round_EXPLODED:
+		return rep.BombOutcomeExploded
+	default:
+		return ""
+	}
+}
+
+func mapFromProjectiles(projectiles []*gen.Replay_Projectile) []rep.Projectile {
+	if projectiles == nil {
+		return nil
+	}
+
+	result := make([]rep.Projectile, len(projectiles))
+	for i, p := range projectiles {
+		result[i] = rep.Projectile{
+			ID:             int(p.Id),
+			ThrowerID:      int(p.ThrowerId),
+			Type:           int(p.Type),
+			ThrowTick:      int(p.ThrowTick),
+			DetonationTick: int(p.DetonationTick),
+			Trajectory:     mapFromPositions(p.Trajectory),
+		}
+	}
+
+	return result
+}
+
 func mapFromEvents(events []*gen.Replay_Tick_Event) []rep.Event {
 	if events == nil {
 		return nil
@@ -0,0 +1,391 @@
+package replay
+
+import "sort"
+
+// Flags for EntityUpdate.ChangedFields, marking which fields of a delta-update have changed since the last snapshot.
+// Fields that aren't marked as changed keep their previous value.
+const (
+	FieldTeam = 1 << iota
+	FieldPositions
+	FieldAngleX
+	FieldAngleY
+	FieldHp
+	FieldArmor
+	FieldFlashDuration
+	FieldIsNpc
+	FieldHasHelmet
+	FieldHasDefuseKit
+	FieldEquipment
+	FieldEconomy
+	FieldStats
+	FieldVelocity
+	FieldIsDucking
+	FieldIsWalking
+	FieldIsAirborne
+	FieldIsScoped
+	FieldIsDefusing
+	FieldIsPlanting
+	FieldIsReloading
+	FieldActiveWeapon // ActiveWeapon & ActiveWeaponIndex
+)
+
+// DeltaEntityUpdate returns an update that only contains the fields of cur that differ from prev.
+// The second return value is false if nothing has changed.
+func DeltaEntityUpdate(prev, cur EntityUpdate) (EntityUpdate, bool) {
+	delta := EntityUpdate{
+		EntityID: cur.EntityID,
+	}
+
+	if prev.Team != cur.Team {
+		delta.Team = cur.Team
+		delta.ChangedFields |= FieldTeam
+	}
+
+	if !samePosition(lastPosition(prev.Positions), cur.Positions) {
+		delta.Positions = cur.Positions
+		delta.ChangedFields |= FieldPositions
+	}
+
+	if prev.AngleX != cur.AngleX {
+		delta.AngleX = cur.AngleX
+		delta.ChangedFields |= FieldAngleX
+	}
+
+	if prev.AngleY != cur.AngleY {
+		delta.AngleY = cur.AngleY
+		delta.ChangedFields |= FieldAngleY
+	}
+
+	if prev.Hp != cur.Hp {
+		delta.Hp = cur.Hp
+		delta.ChangedFields |= FieldHp
+	}
+
+	if prev.Armor != cur.Armor {
+		delta.Armor = cur.Armor
+		delta.ChangedFields |= FieldArmor
+	}
+
+	if prev.FlashDuration != cur.FlashDuration {
+		delta.FlashDuration = cur.FlashDuration
+		delta.ChangedFields |= FieldFlashDuration
+	}
+
+	if prev.IsNpc != cur.IsNpc {
+		delta.IsNpc = cur.IsNpc
+		delta.ChangedFields |= FieldIsNpc
+	}
+
+	if prev.HasHelmet != cur.HasHelmet {
+		delta.HasHelmet = cur.HasHelmet
+		delta.ChangedFields |= FieldHasHelmet
+	}
+
+	if prev.HasDefuseKit != cur.HasDefuseKit {
+		delta.HasDefuseKit = cur.HasDefuseKit
+		delta.ChangedFields |= FieldHasDefuseKit
+	}
+
+	if !equalEquipment(prev.Equipment, cur.Equipment) {
+		delta.Equipment = cur.Equipment
+		delta.ChangedFields |= FieldEquipment
+	}
+
+	if prev.ActiveWeapon != cur.ActiveWeapon || prev.ActiveWeaponIndex != cur.ActiveWeaponIndex {
+		delta.ActiveWeapon = cur.ActiveWeapon
+		delta.ActiveWeaponIndex = cur.ActiveWeaponIndex
+		delta.ChangedFields |= FieldActiveWeapon
+	}
+
+	if !equalEconomy(prev.Economy, cur.Economy) {
+		delta.Economy = cur.Economy
+		delta.ChangedFields |= FieldEconomy
+	}
+
+	if !equalStats(prev.Stats, cur.Stats) {
+		delta.Stats = cur.Stats
+		delta.ChangedFields |= FieldStats
+	}
+
+	if !equalPoint(prev.Velocity, cur.Velocity) {
+		delta.Velocity = cur.Velocity
+		delta.ChangedFields |= FieldVelocity
+	}
+
+	if prev.IsDucking != cur.IsDucking {
+		delta.IsDucking = cur.IsDucking
+		delta.ChangedFields |= FieldIsDucking
+	}
+
+	if prev.IsWalking != cur.IsWalking {
+		delta.IsWalking = cur.IsWalking
+		delta.ChangedFields |= FieldIsWalking
+	}
+
+	if prev.IsAirborne != cur.IsAirborne {
+		delta.IsAirborne = cur.IsAirborne
+		delta.ChangedFields |= FieldIsAirborne
+	}
+
+	if prev.IsScoped != cur.IsScoped {
+		delta.IsScoped = cur.IsScoped
+		delta.ChangedFields |= FieldIsScoped
+	}
+
+	if prev.IsDefusing != cur.IsDefusing {
+		delta.IsDefusing = cur.IsDefusing
+		delta.ChangedFields |= FieldIsDefusing
+	}
+
+	if prev.IsPlanting != cur.IsPlanting {
+		delta.IsPlanting = cur.IsPlanting
+		delta.ChangedFields |= FieldIsPlanting
+	}
+
+	if prev.IsReloading != cur.IsReloading {
+		delta.IsReloading = cur.IsReloading
+		delta.ChangedFields |= FieldIsReloading
+	}
+
+	return delta, delta.ChangedFields != 0
+}
+
+// ApplyDelta returns a copy of u with all changed fields of delta applied.
+// If no positions changed the last known position is carried over.
+func (u EntityUpdate) ApplyDelta(delta EntityUpdate) EntityUpdate {
+	res := u
+	res.EntityID = delta.EntityID
+	res.ChangedFields = 0
+	res.Positions = lastPosition(u.Positions)
+
+	if delta.ChangedFields&FieldTeam != 0 {
+		res.Team = delta.Team
+	}
+
+	if delta.ChangedFields&FieldPositions != 0 {
+		res.Positions = delta.Positions
+	}
+
+	if delta.ChangedFields&FieldAngleX != 0 {
+		res.AngleX = delta.AngleX
+	}
+
+	if delta.ChangedFields&FieldAngleY != 0 {
+		res.AngleY = delta.AngleY
+	}
+
+	if delta.ChangedFields&FieldHp != 0 {
+		res.Hp = delta.Hp
+	}
+
+	if delta.ChangedFields&FieldArmor != 0 {
+		res.Armor = delta.Armor
+	}
+
+	if delta.ChangedFields&FieldFlashDuration != 0 {
+		res.FlashDuration = delta.FlashDuration
+	}
+
+	if delta.ChangedFields&FieldIsNpc != 0 {
+		res.IsNpc = delta.IsNpc
+	}
+
+	if delta.ChangedFields&FieldHasHelmet != 0 {
+		res.HasHelmet = delta.HasHelmet
+	}
+
+	if delta.ChangedFields&FieldHasDefuseKit != 0 {
+		res.HasDefuseKit = delta.HasDefuseKit
+	}
+
+	if delta.ChangedFields&FieldEquipment != 0 {
+		res.Equipment = delta.Equipment
+	}
+
+	if delta.ChangedFields&FieldActiveWeapon != 0 {
+		res.ActiveWeapon = delta.ActiveWeapon
+		res.ActiveWeaponIndex = delta.ActiveWeaponIndex
+	}
+
+	if delta.ChangedFields&FieldEconomy != 0 {
+		res.Economy = delta.Economy
+	}
+
+	if delta.ChangedFields&FieldStats != 0 {
+		res.Stats = delta.Stats
+	}
+
+	if delta.ChangedFields&FieldVelocity != 0 {
+		res.Velocity = delta.Velocity
+	}
+
+	if delta.ChangedFields&FieldIsDucking != 0 {
+		res.IsDucking = delta.IsDucking
+	}
+
+	if delta.ChangedFields&FieldIsWalking != 0 {
+		res.IsWalking = delta.IsWalking
+	}
+
+	if delta.ChangedFields&FieldIsAirborne != 0 {
+		res.IsAirborne = delta.IsAirborne
+	}
+
+	if delta.ChangedFields&FieldIsScoped != 0 {
+		res.IsScoped = delta.IsScoped
+	}
+
+	if delta.ChangedFields&FieldIsDefusing != 0 {
+		res.IsDefusing = delta.IsDefusing
+	}
+
+	if delta.ChangedFields&FieldIsPlanting != 0 {
+		res.IsPlanting = delta.IsPlanting
+	}
+
+	if delta.ChangedFields&FieldIsReloading != 0 {
+		res.IsReloading = delta.IsReloading
+	}
+
+	return res
+}
+
+// SnapshotDecoder reconstructs full snapshots from a sequence of (delta-)snapshots.
+// Snapshots must be passed to Decode in the order they were recorded.
+type SnapshotDecoder struct {
+	state map[int]EntityUpdate
+}
+
+// Decode returns the full state of all entities at the tick of snap.
+// Full snapshots (keyframes) reset the decoder state and are returned as-is.
+func (d *SnapshotDecoder) Decode(snap Snapshot) Snapshot {
+	if !snap.Delta {
+		d.state = make(map[int]EntityUpdate, len(snap.EntityUpdates))
+
+		for _, u := range snap.EntityUpdates {
+			d.state[u.EntityID] = u
+		}
+
+		return snap
+	}
+
+	if d.state == nil {
+		d.state = make(map[int]EntityUpdate)
+	}
+
+	for _, id := range snap.RemovedEntityIDs {
+		delete(d.state, id)
+	}
+
+	for _, delta := range snap.EntityUpdates {
+		d.state[delta.EntityID] = d.state[delta.EntityID].ApplyDelta(delta)
+	}
+
+	full := Snapshot{
+		Tick:     snap.Tick,
+		Bomb:     snap.Bomb,
+		Infernos: snap.Infernos,
+		Items:    snap.Items,
+		Hostages: snap.Hostages,
+	}
+
+	for id, u := range d.state {
+		// Entities that weren't part of this delta didn't move
+		if !snapContainsEntity(snap, id) {
+			u.Positions = lastPosition(u.Positions)
+			d.state[id] = u
+		}
+
+		full.EntityUpdates = append(full.EntityUpdates, u)
+	}
+
+	sort.Slice(full.EntityUpdates, func(i, j int) bool {
+		return full.EntityUpdates[i].EntityID < full.EntityUpdates[j].EntityID
+	})
+
+	return full
+}
+
+// FullSnapshots resolves all delta snapshots into full snapshots.
+// Replays recorded without delta snapshots are returned unchanged.
+func FullSnapshots(snaps []Snapshot) []Snapshot {
+	var dec SnapshotDecoder
+
+	result := make([]Snapshot, len(snaps))
+	for i, s := range snaps {
+		result[i] = dec.Decode(s)
+	}
+
+	return result
+}
+
+func snapContainsEntity(snap Snapshot, entityID int) bool {
+	for _, u := range snap.EntityUpdates {
+		if u.EntityID == entityID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lastPosition(positions []Point) []Point {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	return positions[len(positions)-1:]
+}
+
+// samePosition reports whether all positions in cur are equal to the single position in prev.
+func samePosition(prev, cur []Point) bool {
+	if len(prev) != 1 || len(cur) == 0 {
+		return len(prev) == len(cur)
+	}
+
+	for _, p := range cur {
+		if p != prev[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalEquipment(a, b []EntityEquipment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalEconomy(a, b *EntityEconomy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func equalStats(a, b *EntityStats) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func equalPoint(a, b *Point) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -25,6 +25,17 @@ func init() {
 		IsNpc: true,
 		Name:  "Batman",
 		Team:  2,
+
+		SteamID64:       76561198000000000,
+		AccountID:       39734272,
+		ClanTag:         "JL",
+		Rank:            18,
+		CompetitiveWins: 1337,
+		CrosshairCode:   "CSGO-abcde-fghij-klmno-pqrst-uvwxy",
+
+		Tick:      64,
+		JoinTick:  32,
+		LeaveTick: 96,
 	})
 
 	var pos []rep.Point
@@ -53,12 +64,82 @@ func init() {
 				AmmoReserve:    10,
 				AmmoInMagazine: 30,
 			},
-		}})
+			{
+				Type:           404,
+				AmmoReserve:    1,
+				AmmoInMagazine: 1,
+			},
+		},
+		ActiveWeapon:      404,
+		ActiveWeaponIndex: 1,
+		Economy: &rep.EntityEconomy{
+			Money:               4750,
+			EquipmentValue:      5400,
+			MoneySpentThisRound: 3700,
+			StartMoney:          8450,
+		},
+		Stats: &rep.EntityStats{
+			Kills:   21,
+			Deaths:  14,
+			Assists: 5,
+			MVPs:    3,
+			Score:   52,
+			Ping:    32,
+		},
+		Velocity: &rep.Point{
+			X: 250,
+			Y: -13,
+			Z: 7,
+		},
+		IsDucking:     true,
+		IsWalking:     true,
+		IsAirborne:    true,
+		IsScoped:      true,
+		IsDefusing:    true,
+		IsPlanting:    true,
+		IsReloading:   true,
+		ChangedFields: rep.FieldPositions | rep.FieldHp,
+	})
 
 	var snaps []rep.Snapshot
 	snaps = append(snaps, rep.Snapshot{
-		Tick:          1,
-		EntityUpdates: entUpd,
+		Tick:             1,
+		Delta:            true,
+		EntityUpdates:    entUpd,
+		RemovedEntityIDs: []int{7},
+		Bomb: &rep.Bomb{
+			CarrierID: 5,
+			Position: &rep.Point{
+				X: -1450,
+				Y: 2560,
+				Z: 128,
+			},
+			IsPlanted:       true,
+			Site:            "A",
+			TimeToExplosion: 23.5,
+			DefuserID:       6,
+			DefuseProgress:  0.4,
+		},
+		Infernos: []rep.Inferno{{
+			ID:        77,
+			ThrowerID: 6,
+			Fires: []rep.Point{
+				{X: -300, Y: 1200, Z: 32},
+				{X: -280, Y: 1240, Z: 33},
+			},
+		}},
+		Items: []rep.Item{{
+			ID:       88,
+			Type:     406,
+			Position: rep.Point{X: 512, Y: -768, Z: 16},
+		}},
+		Hostages: []rep.Hostage{{
+			ID:        99,
+			Position:  rep.Point{X: 1024, Y: 2048, Z: -64},
+			State:     3,
+			CarrierID: 4,
+			Hp:        85,
+		}},
 	})
 
 	var attrs []rep.EventAttribute
@@ -91,13 +172,73 @@ func init() {
 
 	replay := rep.Replay{
 		Header: rep.Header{
-			MapName:      "de_test",
-			SnapshotRate: 64,
-			TickRate:     128,
+			MapName:              "de_test",
+			SnapshotRate:         64,
+			TickRate:             128,
+			PositionSampleRate:   16,
+			ProjectileSampleRate: 4,
+			ServerName:           "Valve CS:GO EU West Server",
+			ClientName:           "GOTV Demo",
+			PlaybackTime:         2400.5,
+			PlaybackTicks:        307264,
+			PlaybackFrames:       153632,
+			NetworkProtocol:      13765,
+			Filestamp:            "HL2DEMO",
+			MinifierVersion:      "v1.2.3",
+		},
+		Footer: rep.Footer{
+			Teams: []rep.Team{
+				{
+					ClanName: "Team Liquid",
+					Flag:     "US",
+					Sides:    []int{2, 3},
+				},
+				{
+					ClanName: "Renegades",
+					Flag:     "AU",
+					Sides:    []int{3, 2},
+				},
+			},
+			Scores: []rep.Score{
+				{
+					Tick:              1024,
+					Round:             1,
+					Terrorists:        1,
+					CounterTerrorists: 2,
+				},
+			},
+			SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
 		},
 		Entities:  ent,
 		Snapshots: snaps,
 		Ticks:     ticks,
+		Warnings: []rep.Warning{{
+			Tick:    3,
+			Type:    1,
+			Message: "bombsite unknown",
+		}},
+		Rounds: []rep.Round{{
+			Nr:                     1,
+			StartTick:              100,
+			FreezeTimeEndTick:      200,
+			EndTick:                300,
+			Winner:                 3,
+			Reason:                 8,
+			ScoreTerrorists:        1,
+			ScoreCounterTerrorists: 2,
+			BombOutcome:            rep.BombOutcomeDefused,
+		}},
+		Projectiles: []rep.Projectile{{
+			ID:             42,
+			ThrowerID:      5,
+			Type:           505,
+			ThrowTick:      128,
+			DetonationTick: 256,
+			Trajectory: []rep.Point{
+				{X: 100, Y: 200, Z: 64},
+				{X: 150, Y: 260, Z: 96},
+			},
+		}},
 	}
 
 	// Check for nested default values in the testdata.
@@ -271,6 +412,9 @@ func deepValueUnEqual(v1, v2 reflect.Value, visited map[visit]bool, depth int) b
 		return deepValueUnEqual(v1.Elem(), v2.Elem(), visited, depth+1)
 
 	case reflect.Ptr:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() != v2.IsNil()
+		}
 		if v1.Pointer() == v2.Pointer() {
 			return false
 		}
@@ -3,14 +3,31 @@ package replay
 
 // Possible attribute kinds
 const (
-	AttrKindEntityID  = "entityId"
-	AttrKindVictim    = "victim"
-	AttrKindKiller    = "killer"
-	AttrKindAssister  = "assister"
-	AttrKindText      = "text"
-	AttrKindSender    = "sender"
-	AttrKindWeapon    = "weapon"
-	AttrKindThrowerID = "throwerEntityId"
+	AttrKindEntityID          = "entityId"
+	AttrKindVictim            = "victim"
+	AttrKindKiller            = "killer"
+	AttrKindAssister          = "assister"
+	AttrKindText              = "text"
+	AttrKindSender            = "sender"
+	AttrKindWeapon            = "weapon"
+	AttrKindThrowerID         = "throwerEntityId"
+	AttrKindSite              = "site"
+	AttrKindProjectileID      = "projectileId"
+	AttrKindItemID            = "itemId"
+	AttrKindHostageID         = "hostageId"
+	AttrKindIsHeadshot        = "isHeadshot"
+	AttrKindPenetratedObjects = "penetratedObjects"
+	AttrKindIsNoScope         = "isNoScope"
+	AttrKindIsThroughSmoke    = "isThroughSmoke"
+	AttrKindIsAttackerBlind   = "isAttackerBlind"
+	AttrKindIsAssistedFlash   = "isAssistedFlash"
+	AttrKindDistance          = "distance"
+	AttrKindAttacker          = "attacker"
+	AttrKindHealthDamage      = "healthDamage"
+	AttrKindArmorDamage       = "armorDamage"
+	AttrKindHealth            = "health"
+	AttrKindArmor             = "armor"
+	AttrKindHitGroup          = "hitGroup"
 )
 
 // Possible event types
@@ -36,35 +53,153 @@ const (
 	EventFireGrenadeExpired = "fire_grenade_expired"
 	EventHEGrenadeExplosion = "he_grenade_explosion"
 	EventFlashExplosion     = "flash_explosion"
+	EventWeaponSwitch       = "weapon_switch"
+	EventBombPickup         = "bomb_pickup"
+	EventBombDropped        = "bomb_dropped"
+	EventBombPlantBegin     = "bomb_plant_begin"
+	EventBombPlantAborted   = "bomb_plant_aborted"
+	EventBombPlanted        = "bomb_planted"
+	EventBombDefuseStart    = "bomb_defuse_start"
+	EventBombDefuseAborted  = "bomb_defuse_aborted"
+	EventBombDefused        = "bomb_defused"
+	EventBombExploded       = "bomb_exploded"
+	EventGrenadeThrown      = "grenade_thrown"
+	EventGrenadeLanded      = "grenade_landed"
+	EventItemPickup         = "item_pickup"
+	EventItemDrop           = "item_drop"
+	EventHostagePickup      = "hostage_pickup"
+	EventHostageRescued     = "hostage_rescued"
+	EventHostageKilled      = "hostage_killed"
+)
+
+// Possible bomb outcomes of a round
+const (
+	BombOutcomePlanted  = "planted" // Planted, but neither defused nor exploded (e.g. all CTs died)
+	BombOutcomeDefused  = "defused"
+	BombOutcomeExploded = "exploded"
 )
 
 // Replay contains a minified demo
 type Replay struct {
-	Header    Header     `json:"header" msgpack:"header"`
-	Entities  []Entity   `json:"entities" msgpack:"entities"`
-	Snapshots []Snapshot `json:"snapshots" msgpack:"snapshots"`
-	Ticks     []Tick     `json:"ticks" msgpack:"ticks"`
+	Header      Header       `json:"header" msgpack:"header"`
+	Entities    []Entity     `json:"entities" msgpack:"entities"`
+	Snapshots   []Snapshot   `json:"snapshots" msgpack:"snapshots"`
+	Ticks       []Tick       `json:"ticks" msgpack:"ticks"`
+	Warnings    []Warning    `json:"warnings,omitempty" msgpack:"warnings,omitempty"`
+	Rounds      []Round      `json:"rounds,omitempty" msgpack:"rounds,omitempty"`
+	Projectiles []Projectile `json:"projectiles,omitempty" msgpack:"projectiles,omitempty"`
+	Footer      Footer       `json:"footer" msgpack:"footer"`
 }
 
 // Header holds the replay's general information
 type Header struct {
-	MapName      string  `json:"map" msgpack:"map"`
-	TickRate     float64 `json:"tickRate" msgpack:"tickRate"`         // How many ticks per second
-	SnapshotRate int     `json:"snapshotRate" msgpack:"snapshotRate"` // How many ticks per snapshot
+	MapName              string  `json:"map" msgpack:"map"`
+	TickRate             float64 `json:"tickRate" msgpack:"tickRate"`                                             // How many ticks per second
+	SnapshotRate         int     `json:"snapshotRate" msgpack:"snapshotRate"`                                     // How many ticks per snapshot
+	PositionSampleRate   int     `json:"positionSampleRate,omitempty" msgpack:"positionSampleRate,omitempty"`     // How many ticks per position sample (on ticks that are a multiple of it), 0 if only one position is recorded per snapshot
+	ProjectileSampleRate int     `json:"projectileSampleRate,omitempty" msgpack:"projectileSampleRate,omitempty"` // How many ticks per projectile trajectory point
+
+	// Information from the demo header
+	ServerName      string  `json:"serverName,omitempty" msgpack:"serverName,omitempty"`
+	ClientName      string  `json:"clientName,omitempty" msgpack:"clientName,omitempty"`
+	PlaybackTime    float64 `json:"playbackTime,omitempty" msgpack:"playbackTime,omitempty"` // Demo duration in seconds
+	PlaybackTicks   int     `json:"playbackTicks,omitempty" msgpack:"playbackTicks,omitempty"`
+	PlaybackFrames  int     `json:"playbackFrames,omitempty" msgpack:"playbackFrames,omitempty"`
+	NetworkProtocol int     `json:"networkProtocol,omitempty" msgpack:"networkProtocol,omitempty"`
+	Filestamp       string  `json:"filestamp,omitempty" msgpack:"filestamp,omitempty"`
+
+	MinifierVersion string `json:"minifierVersion,omitempty" msgpack:"minifierVersion,omitempty"` // Version of cs-demo-minifier that created the replay
+}
+
+// Footer holds the replay's information that is only known once the whole demo has been parsed.
+// It always covers the whole match, regardless of the tick & round selection and the player filter.
+type Footer struct {
+	Teams  []Team  `json:"teams,omitempty" msgpack:"teams,omitempty"`   // The two teams playing the match, the team that started as terrorists first
+	Scores []Score `json:"scores,omitempty" msgpack:"scores,omitempty"` // The score of both sides, a new entry is added whenever it changes
+	SHA256 string  `json:"sha256,omitempty" msgpack:"sha256,omitempty"` // Hex encoded SHA-256 checksum of the demo file
+}
+
+// Team holds information about one of the teams playing the match
+type Team struct {
+	ClanName string `json:"clanName,omitempty" msgpack:"clanName,omitempty"`
+	Flag     string `json:"flag,omitempty" msgpack:"flag,omitempty"`
+	Sides    []int  `json:"sides" msgpack:"sides"` // The side (entity team) the team played on, a new entry is added every time the teams switch sides (e.g. at half time)
 }
 
-// Entity holds players & NPCs
+// Score contains the score of both sides at a specific tick
+type Score struct {
+	Tick              int `json:"tick" msgpack:"tick"`
+	Round             int `json:"round" msgpack:"round"` // The round that was played when the score changed, starting at 1
+	Terrorists        int `json:"terrorists" msgpack:"terrorists"`
+	CounterTerrorists int `json:"counterTerrorists" msgpack:"counterTerrorists"`
+}
+
+// Entity holds players & NPCs.
+// Every time a player joins the game a new entity record is added, another record follows whenever
+// the entity's information changes (e.g. name or team) and when it leaves the game.
+// Entity IDs may be reused after a player left, use EntityAt() to find the record that is valid at a specific tick
+// and SteamID64 to identify players across reconnects.
 type Entity struct {
-	ID    int    `json:"id" msgpack:"id"`
-	Name  string `json:"name" msgpack:"name"`
-	Team  int    `json:"team" msgpack:"team"`
-	IsNpc bool   `json:"isNpc,omitempty" msgpack:"isNpc,omitempty"`
+	ID              int    `json:"id" msgpack:"id"`
+	Name            string `json:"name" msgpack:"name"`
+	Team            int    `json:"team" msgpack:"team"`
+	IsNpc           bool   `json:"isNpc,omitempty" msgpack:"isNpc,omitempty"`
+	SteamID64       uint64 `json:"steamId64,omitempty" msgpack:"steamId64,omitempty"` // 0 for bots
+	AccountID       uint32 `json:"accountId,omitempty" msgpack:"accountId,omitempty"` // 32-bit Steam account ID
+	ClanTag         string `json:"clanTag,omitempty" msgpack:"clanTag,omitempty"`
+	Rank            int    `json:"rank,omitempty" msgpack:"rank,omitempty"` // Competitive skill group, 0 if unknown
+	CompetitiveWins int    `json:"competitiveWins,omitempty" msgpack:"competitiveWins,omitempty"`
+	CrosshairCode   string `json:"crosshairCode,omitempty" msgpack:"crosshairCode,omitempty"`
+	Tick            int    `json:"tick,omitempty" msgpack:"tick,omitempty"`           // Tick from which on this record is valid
+	JoinTick        int    `json:"joinTick,omitempty" msgpack:"joinTick,omitempty"`   // Tick at which the player joined (or was first seen)
+	LeaveTick       int    `json:"leaveTick,omitempty" msgpack:"leaveTick,omitempty"` // Tick at which the player left, 0 if still connected
 }
 
-// Snapshot contains state changes since the last snapshot
+// Snapshot contains the state of all entities at a specific tick.
+// Delta snapshots only contain state changes since the last snapshot, see SnapshotDecoder.
 type Snapshot struct {
-	Tick          int            `json:"tick" msgpack:"tick"`
-	EntityUpdates []EntityUpdate `json:"entityUpdates" msgpack:"entityUpdates"`
+	Tick             int            `json:"tick" msgpack:"tick"`
+	Delta            bool           `json:"delta,omitempty" msgpack:"delta,omitempty"`
+	EntityUpdates    []EntityUpdate `json:"entityUpdates" msgpack:"entityUpdates"`
+	RemovedEntityIDs []int          `json:"removedEntityIds,omitempty" msgpack:"removedEntityIds,omitempty"` // Entities that are no longer alive, only set on delta snapshots
+	Bomb             *Bomb          `json:"bomb,omitempty" msgpack:"bomb,omitempty"`                         // nil if there is no bomb, always the full state (also on delta snapshots)
+	Infernos         []Inferno      `json:"infernos,omitempty" msgpack:"infernos,omitempty"`                 // Burning molotovs & incendiaries, always the full state (also on delta snapshots)
+	Items            []Item         `json:"items,omitempty" msgpack:"items,omitempty"`                       // Dropped weapons & defuse kits, always the full state (also on delta snapshots)
+	Hostages         []Hostage      `json:"hostages,omitempty" msgpack:"hostages,omitempty"`                 // Always the full state (also on delta snapshots)
+}
+
+// Hostage contains the state of a hostage
+type Hostage struct {
+	ID        int   `json:"id" msgpack:"id"` // Entity-ID of the hostage
+	Position  Point `json:"position" msgpack:"position"`
+	State     int   `json:"state" msgpack:"state"`                             // See demoinfocs common.HostageState
+	CarrierID int   `json:"carrierId,omitempty" msgpack:"carrierId,omitempty"` // Entity-ID of the player carrying (or being followed by) the hostage
+	Hp        int   `json:"hp" msgpack:"hp"`
+}
+
+// Item contains an item that is lying around in the world
+type Item struct {
+	ID       int   `json:"id" msgpack:"id"`     // Entity-ID of the item
+	Type     int   `json:"type" msgpack:"type"` // See demoinfocs common.EquipmentType
+	Position Point `json:"position" msgpack:"position"`
+}
+
+// Inferno contains the area covered by the fire of a molotov or incendiary
+type Inferno struct {
+	ID        int     `json:"id" msgpack:"id"`                                   // Entity-ID of the inferno, may be reused once the fire is gone
+	ThrowerID int     `json:"throwerId,omitempty" msgpack:"throwerId,omitempty"` // 0 if unknown
+	Fires     []Point `json:"fires" msgpack:"fires"`                             // Positions of the fire cells that are currently burning
+}
+
+// Bomb contains the state of the bomb
+type Bomb struct {
+	CarrierID       int     `json:"carrierId,omitempty" msgpack:"carrierId,omitempty"` // Entity-ID of the player carrying the bomb, 0 if dropped or planted
+	Position        *Point  `json:"position,omitempty" msgpack:"position,omitempty"`   // Position of the bomb when dropped or planted
+	IsPlanted       bool    `json:"isPlanted,omitempty" msgpack:"isPlanted,omitempty"`
+	Site            string  `json:"site,omitempty" msgpack:"site,omitempty"`                       // Bombsite ("A" or "B") while planting or planted
+	TimeToExplosion float32 `json:"timeToExplosion,omitempty" msgpack:"timeToExplosion,omitempty"` // Seconds until the planted bomb explodes
+	DefuserID       int     `json:"defuserId,omitempty" msgpack:"defuserId,omitempty"`             // Entity-ID of the player defusing the bomb
+	DefuseProgress  float32 `json:"defuseProgress,omitempty" msgpack:"defuseProgress,omitempty"`   // Between 0 and 1
 }
 
 type EntityEquipment struct {
@@ -73,20 +208,51 @@ type EntityEquipment struct {
 	AmmoReserve    int `json:"ammoReserve" msgpack:"ammoReserve"`
 }
 
+// EntityEconomy contains the money & equipment value of a player
+type EntityEconomy struct {
+	Money               int `json:"money" msgpack:"money"`
+	EquipmentValue      int `json:"equipmentValue" msgpack:"equipmentValue"` // Value of the current equipment
+	MoneySpentThisRound int `json:"moneySpentThisRound" msgpack:"moneySpentThisRound"`
+	StartMoney          int `json:"startMoney" msgpack:"startMoney"` // Money at the start of the round
+}
+
+// EntityStats contains the scoreboard values of a player
+type EntityStats struct {
+	Kills   int `json:"kills" msgpack:"kills"`
+	Deaths  int `json:"deaths" msgpack:"deaths"`
+	Assists int `json:"assists" msgpack:"assists"`
+	MVPs    int `json:"mvps" msgpack:"mvps"`
+	Score   int `json:"score" msgpack:"score"`
+	Ping    int `json:"ping" msgpack:"ping"`
+}
+
 // EntityUpdate contains changes of player & NPCs attributes
 type EntityUpdate struct {
-	EntityID      int               `json:"entityId" msgpack:"entityId"`
-	Team          int               `json:"team,omitempty" msgpack:"team,omitempty"`
-	Positions     []Point           `json:"positions,omitempty" msgpack:"positions,omitempty"` // This allows us smoother replay with less overhead compared to higher snapshot rate
-	AngleX        int               `json:"angleX,omitempty" msgpack:"angleX,omitempty"`
-	AngleY        int               `json:"angleY,omitempty" msgpack:"angleY,omitempty"`
-	Hp            int               `json:"hp,omitempty" msgpack:"hp,omitempty"`
-	Armor         int               `json:"armor,omitempty" msgpack:"armor,omitempty"`
-	FlashDuration float32           `json:"flashDuration,omitempty" msgpack:"flashDuration,omitempty"`
-	IsNpc         bool              `json:"isNpc,omitempty" msgpack:"isNpc,omitempty"`
-	HasHelmet     bool              `json:"hasHelmet,omitempty" msgpack:"hasHelmet,omitempty"`
-	HasDefuseKit  bool              `json:"hasDefuseKit,omitempty" msgpack:"hasDefuseKit,omitempty"`
-	Equipment     []EntityEquipment `json:"equipment,omitempty" msgpack:"equipment,omitempty"`
+	EntityID          int               `json:"entityId" msgpack:"entityId"`
+	Team              int               `json:"team,omitempty" msgpack:"team,omitempty"`
+	Positions         []Point           `json:"positions,omitempty" msgpack:"positions,omitempty"` // Positions sampled since the last snapshot (see Header.PositionSampleRate), the last one is the position at the snapshot's tick - which is closer to the previous sample if the sample rate doesn't divide the snapshot rate
+	AngleX            int               `json:"angleX,omitempty" msgpack:"angleX,omitempty"`
+	AngleY            int               `json:"angleY,omitempty" msgpack:"angleY,omitempty"`
+	Hp                int               `json:"hp,omitempty" msgpack:"hp,omitempty"`
+	Armor             int               `json:"armor,omitempty" msgpack:"armor,omitempty"`
+	FlashDuration     float32           `json:"flashDuration,omitempty" msgpack:"flashDuration,omitempty"`
+	IsNpc             bool              `json:"isNpc,omitempty" msgpack:"isNpc,omitempty"`
+	HasHelmet         bool              `json:"hasHelmet,omitempty" msgpack:"hasHelmet,omitempty"`
+	HasDefuseKit      bool              `json:"hasDefuseKit,omitempty" msgpack:"hasDefuseKit,omitempty"`
+	Equipment         []EntityEquipment `json:"equipment,omitempty" msgpack:"equipment,omitempty"`
+	ActiveWeapon      int               `json:"activeWeapon,omitempty" msgpack:"activeWeapon,omitempty"`           // Type of the weapon the player is holding, 0 if none
+	ActiveWeaponIndex int               `json:"activeWeaponIndex,omitempty" msgpack:"activeWeaponIndex,omitempty"` // Index of the active weapon in Equipment
+	Economy           *EntityEconomy    `json:"economy,omitempty" msgpack:"economy,omitempty"`
+	Stats             *EntityStats      `json:"stats,omitempty" msgpack:"stats,omitempty"`
+	Velocity          *Point            `json:"velocity,omitempty" msgpack:"velocity,omitempty"` // Units per second
+	IsDucking         bool              `json:"isDucking,omitempty" msgpack:"isDucking,omitempty"`
+	IsWalking         bool              `json:"isWalking,omitempty" msgpack:"isWalking,omitempty"`
+	IsAirborne        bool              `json:"isAirborne,omitempty" msgpack:"isAirborne,omitempty"`
+	IsScoped          bool              `json:"isScoped,omitempty" msgpack:"isScoped,omitempty"`
+	IsDefusing        bool              `json:"isDefusing,omitempty" msgpack:"isDefusing,omitempty"`
+	IsPlanting        bool              `json:"isPlanting,omitempty" msgpack:"isPlanting,omitempty"`
+	IsReloading       bool              `json:"isReloading,omitempty" msgpack:"isReloading,omitempty"`
+	ChangedFields     uint32            `json:"changedFields,omitempty" msgpack:"changedFields,omitempty"` // Only set on delta snapshots, see Field* constants
 }
 
 // Point is a position on the map
@@ -114,3 +280,100 @@ type EventAttribute struct {
 	StrVal string  `json:"strVal,omitempty" msgpack:"strVal,omitempty"`
 	NumVal float64 `json:"numVal,omitempty" msgpack:"numVal,omitempty"`
 }
+
+// Round contains the summary of a round
+type Round struct {
+	Nr                     int    `json:"nr" msgpack:"nr"` // Starting at 1, warmup rounds aren't recorded
+	StartTick              int    `json:"startTick" msgpack:"startTick"`
+	FreezeTimeEndTick      int    `json:"freezeTimeEndTick,omitempty" msgpack:"freezeTimeEndTick,omitempty"`
+	EndTick                int    `json:"endTick,omitempty" msgpack:"endTick,omitempty"` // 0 if the demo ended before the round did
+	Winner                 int    `json:"winner,omitempty" msgpack:"winner,omitempty"`   // The winning side
+	Reason                 int    `json:"reason,omitempty" msgpack:"reason,omitempty"`   // See demoinfocs events.RoundEndReason
+	ScoreTerrorists        int    `json:"scoreTerrorists" msgpack:"scoreTerrorists"`     // Score after the round
+	ScoreCounterTerrorists int    `json:"scoreCounterTerrorists" msgpack:"scoreCounterTerrorists"`
+	BombOutcome            string `json:"bombOutcome,omitempty" msgpack:"bombOutcome,omitempty"` // See BombOutcome* constants, empty if the bomb wasn't planted
+}
+
+// Projectile contains the flight of a grenade from the throw until the detonation
+type Projectile struct {
+	ID             int     `json:"id" msgpack:"id"` // Entity-ID of the projectile, may be reused once the projectile is gone
+	ThrowerID      int     `json:"throwerId,omitempty" msgpack:"throwerId,omitempty"`
+	Type           int     `json:"type" msgpack:"type"` // See demoinfocs common.EquipmentType
+	ThrowTick      int     `json:"throwTick" msgpack:"throwTick"`
+	DetonationTick int     `json:"detonationTick,omitempty" msgpack:"detonationTick,omitempty"` // 0 if the demo ended before the detonation
+	Trajectory     []Point `json:"trajectory" msgpack:"trajectory"`                             // Positions every Header.ProjectileSampleRate ticks since ThrowTick, the last one is the position at DetonationTick
+}
+
+// Warning contains a non-fatal problem that occurred while parsing the demo
+type Warning struct {
+	Tick    int    `json:"tick" msgpack:"tick"`
+	Type    int    `json:"type" msgpack:"type"` // See demoinfocs events.WarnType
+	Message string `json:"message" msgpack:"message"`
+}
+
+// EntityAt returns the most recent record of the entity with the given ID at a specific tick.
+// The second return value is false if no player was using the entity ID at that tick.
+func (r Replay) EntityAt(id, tick int) (Entity, bool) {
+	var (
+		res   Entity
+		found bool
+	)
+
+	for _, e := range r.Entities {
+		if e.ID != id || e.Tick > tick {
+			continue
+		}
+
+		res = e
+		found = e.LeaveTick == 0 || e.LeaveTick > tick
+	}
+
+	return res, found
+}
+
+// StreamRecord contains a single part of a replay that has been written as a stream, exactly one of the fields is set.
+type StreamRecord struct {
+	Header     *Header     `json:"header,omitempty" msgpack:"header,omitempty"`
+	Entity     *Entity     `json:"entity,omitempty" msgpack:"entity,omitempty"`
+	Snapshot   *Snapshot   `json:"snapshot,omitempty" msgpack:"snapshot,omitempty"`
+	Tick       *Tick       `json:"tick,omitempty" msgpack:"tick,omitempty"`
+	Warning    *Warning    `json:"warning,omitempty" msgpack:"warning,omitempty"`
+	Round      *Round      `json:"round,omitempty" msgpack:"round,omitempty"`
+	Projectile *Projectile `json:"projectile,omitempty" msgpack:"projectile,omitempty"`
+	Footer     *Footer     `json:"footer,omitempty" msgpack:"footer,omitempty"`
+}
+
+// AddRecord adds the part of a replay contained in rec to r.
+func (r *Replay) AddRecord(rec StreamRecord) {
+	if rec.Header != nil {
+		r.Header = *rec.Header
+	}
+
+	if rec.Entity != nil {
+		r.Entities = append(r.Entities, *rec.Entity)
+	}
+
+	if rec.Snapshot != nil {
+		r.Snapshots = append(r.Snapshots, *rec.Snapshot)
+	}
+
+	if rec.Tick != nil {
+		r.Ticks = append(r.Ticks, *rec.Tick)
+	}
+
+	if rec.Warning != nil {
+		r.Warnings = append(r.Warnings, *rec.Warning)
+	}
+
+	if rec.Round != nil {
+		r.Rounds = append(r.Rounds, *rec.Round)
+	}
+
+	if rec.Projectile != nil {
+		r.Projectiles = append(r.Projectiles, *rec.Projectile)
+	}
+
+	if rec.Footer != nil {
+		r.Footer = *rec.Footer
+	}
+}
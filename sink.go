@@ -0,0 +1,160 @@
+package csminify
+
+import (
+	"encoding/json"
+	"io"
+
+	msgpack "gopkg.in/vmihailenco/msgpack.v2"
+
+	rep "github.com/markus-wa/cs-demo-minifier/replay"
+)
+
+// ReplaySink receives the parts of a replay as soon as they are available during minification.
+// See also: MinifyToSink
+type ReplaySink interface {
+	// Header is called first, before any other part of the replay.
+	Header(rep.Header) error
+	// Entity is called whenever a new entity is encountered.
+	Entity(rep.Entity) error
+	// Snapshot is called for every snapshot, in order.
+	Snapshot(rep.Snapshot) error
+	// Tick is called for every tick that contains events, in order.
+	Tick(rep.Tick) error
+	// Warning is called for every parser warning if ReplayConfig.RecordWarnings is set.
+	Warning(rep.Warning) error
+	// Round is called at the end of every round that has been (partially) recorded.
+	Round(rep.Round) error
+	// Projectile is called for every grenade projectile once it detonated.
+	Projectile(rep.Projectile) error
+	// Footer is called last, after parsing has finished, with the information that is only known at the end of the demo.
+	Footer(rep.Footer) error
+}
+
+// replayCollector is a ReplaySink that keeps the whole replay in memory.
+type replayCollector struct {
+	replay rep.Replay
+}
+
+func (c *replayCollector) Header(h rep.Header) error {
+	c.replay.Header = h
+	return nil
+}
+
+func (c *replayCollector) Entity(e rep.Entity) error {
+	c.replay.Entities = append(c.replay.Entities, e)
+	return nil
+}
+
+func (c *replayCollector) Snapshot(s rep.Snapshot) error {
+	c.replay.Snapshots = append(c.replay.Snapshots, s)
+	return nil
+}
+
+func (c *replayCollector) Tick(t rep.Tick) error {
+	c.replay.Ticks = append(c.replay.Ticks, t)
+	return nil
+}
+
+func (c *replayCollector) Warning(w rep.Warning) error {
+	c.replay.Warnings = append(c.replay.Warnings, w)
+	return nil
+}
+
+func (c *replayCollector) Round(r rep.Round) error {
+	c.replay.Rounds = append(c.replay.Rounds, r)
+	return nil
+}
+
+func (c *replayCollector) Projectile(p rep.Projectile) error {
+	c.replay.Projectiles = append(c.replay.Projectiles, p)
+	return nil
+}
+
+func (c *replayCollector) Footer(f rep.Footer) error {
+	c.replay.Footer = f
+	return nil
+}
+
+// recordStreamWriter is a ReplaySink that encodes every part of the replay as a separate replay.StreamRecord.
+type recordStreamWriter struct {
+	encode func(v interface{}) error
+}
+
+func (sw recordStreamWriter) Header(h rep.Header) error {
+	return sw.encode(rep.StreamRecord{Header: &h})
+}
+
+func (sw recordStreamWriter) Entity(e rep.Entity) error {
+	return sw.encode(rep.StreamRecord{Entity: &e})
+}
+
+func (sw recordStreamWriter) Snapshot(s rep.Snapshot) error {
+	return sw.encode(rep.StreamRecord{Snapshot: &s})
+}
+
+func (sw recordStreamWriter) Tick(t rep.Tick) error {
+	return sw.encode(rep.StreamRecord{Tick: &t})
+}
+
+func (sw recordStreamWriter) Warning(w rep.Warning) error {
+	return sw.encode(rep.StreamRecord{Warning: &w})
+}
+
+func (sw recordStreamWriter) Round(r rep.Round) error {
+	return sw.encode(rep.StreamRecord{Round: &r})
+}
+
+func (sw recordStreamWriter) Projectile(p rep.Projectile) error {
+	return sw.encode(rep.StreamRecord{Projectile: &p})
+}
+
+func (sw recordStreamWriter) Footer(f rep.Footer) error {
+	return sw.encode(rep.StreamRecord{Footer: &f})
+}
+
+// NewJSONStreamWriter returns a ReplaySink that writes replays as newline delimited JSON to w.
+// Each line contains one replay.StreamRecord, use UnmarshalJSONStream to read them into a Replay again.
+func NewJSONStreamWriter(w io.Writer) ReplaySink {
+	return recordStreamWriter{encode: json.NewEncoder(w).Encode}
+}
+
+// NewMsgPackStreamWriter returns a ReplaySink that writes replays as a sequence of MessagePack encoded replay.StreamRecords to w.
+// Use UnmarshalMsgPackStream to read them into a Replay again.
+func NewMsgPackStreamWriter(w io.Writer) ReplaySink {
+	enc := msgpack.NewEncoder(w)
+
+	return recordStreamWriter{encode: func(v interface{}) error {
+		return enc.Encode(v)
+	}}
+}
+
+// UnmarshalJSONStream reads a replay written by a JSON stream writer from r.
+// See also: NewJSONStreamWriter
+func UnmarshalJSONStream(r io.Reader, replay *rep.Replay) error {
+	return unmarshalRecordStream(json.NewDecoder(r).Decode, replay)
+}
+
+// UnmarshalMsgPackStream reads a replay written by a MessagePack stream writer from r.
+// See also: NewMsgPackStreamWriter
+func UnmarshalMsgPackStream(r io.Reader, replay *rep.Replay) error {
+	dec := msgpack.NewDecoder(r)
+
+	return unmarshalRecordStream(func(v interface{}) error {
+		return dec.Decode(v)
+	}, replay)
+}
+
+func unmarshalRecordStream(decode func(v interface{}) error, replay *rep.Replay) error {
+	for {
+		var rec rep.StreamRecord
+
+		err := decode(&rec)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		replay.AddRecord(rec)
+	}
+}
@@ -0,0 +1,115 @@
+package csminify
+
+import (
+	r3 "github.com/golang/geo/r3"
+	dem "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/common"
+	st "github.com/markus-wa/demoinfocs-golang/v2/pkg/demoinfocs/sendtables"
+)
+
+// Stubs for testing without demos.
+// The stubs embed the interfaces they implement, calling any method that isn't overridden panics.
+
+type entityStub struct {
+	st.Entity
+	id       int
+	position r3.Vector
+	props    map[string]int
+}
+
+func (e *entityStub) ID() int {
+	return e.id
+}
+
+func (e *entityStub) Position() r3.Vector {
+	return e.position
+}
+
+func (e *entityStub) PropertyValue(name string) (st.PropertyValue, bool) {
+	val, ok := e.props[name]
+
+	return st.PropertyValue{IntVal: val}, ok
+}
+
+func (e *entityStub) PropertyValueMust(name string) st.PropertyValue {
+	val, _ := e.PropertyValue(name)
+
+	return val
+}
+
+type parserStub struct {
+	dem.Parser
+	gameState *gameStateStub
+}
+
+func (p *parserStub) GameState() dem.GameState {
+	return p.gameState
+}
+
+type gameStateStub struct {
+	dem.GameState
+	playing  []*common.Player
+	all      []*common.Player // Including disconnected players, defaults to playing
+	hostages []*common.Hostage
+}
+
+func (gs *gameStateStub) Participants() dem.Participants {
+	all := gs.all
+	if all == nil {
+		all = gs.playing
+	}
+
+	return participantsStub{playing: gs.playing, all: all}
+}
+
+func (gs *gameStateStub) Hostages() []*common.Hostage {
+	return gs.hostages
+}
+
+type participantsStub struct {
+	dem.Participants
+	playing []*common.Player
+	all     []*common.Player
+}
+
+func (ptcp participantsStub) Playing() []*common.Player {
+	return ptcp.playing
+}
+
+func (ptcp participantsStub) All() []*common.Player {
+	return ptcp.all
+}
+
+func (ptcp participantsStub) ByEntityID() map[int]*common.Player {
+	res := make(map[int]*common.Player)
+	for _, pl := range ptcp.playing {
+		res[pl.EntityID] = pl
+	}
+
+	return res
+}
+
+// demoInfoProviderStub resolves the hostages' leaders.
+type demoInfoProviderStub struct {
+	playersByHandle map[int]*common.Player
+}
+
+func (demoInfoProviderStub) IngameTick() int {
+	return 0
+}
+
+func (demoInfoProviderStub) TickRate() float64 {
+	return 64
+}
+
+func (p demoInfoProviderStub) FindPlayerByHandle(handle int) *common.Player {
+	return p.playersByHandle[handle]
+}
+
+func (demoInfoProviderStub) PlayerResourceEntity() st.Entity {
+	return nil
+}
+
+func (demoInfoProviderStub) FindWeaponByEntityID(int) *common.Equipment {
+	return nil
+}